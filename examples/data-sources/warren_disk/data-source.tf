@@ -0,0 +1,3 @@
+data "warren_disk" "data" {
+  id = "12345678-9abc-def0-1234-56789abcdef0"
+}
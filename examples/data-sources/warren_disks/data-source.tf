@@ -0,0 +1,3 @@
+data "warren_disks" "orphaned" {
+  attached = false
+}
@@ -0,0 +1,3 @@
+data "warren_floating_ip" "ingress" {
+  name = "public-ingress"
+}
@@ -0,0 +1,3 @@
+data "warren_floating_ips" "unassigned" {
+  assigned = false
+}
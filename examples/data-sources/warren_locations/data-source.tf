@@ -0,0 +1,3 @@
+data "warren_locations" "estonia" {
+  country_code = "est"
+}
@@ -0,0 +1,3 @@
+data "warren_networks" "private" {
+  type = "private"
+}
@@ -2,3 +2,9 @@ data "warren_os_base_image" "ubuntu" {
   os_name    = "ubuntu"
   os_version = "22.04"
 }
+
+data "warren_os_base_image" "ubuntu_lts" {
+  os_name               = "ubuntu"
+  os_version_constraint = ">= 22.04"
+  most_recent           = true
+}
@@ -0,0 +1,4 @@
+data "warren_os_base_images" "published" {
+  is_app_catalog = false
+  published      = true
+}
@@ -0,0 +1,3 @@
+data "warren_virtual_machine" "web" {
+  name = "web"
+}
@@ -0,0 +1,4 @@
+data "warren_virtual_machines" "web" {
+  name_prefix = "web-"
+  status      = "running"
+}
@@ -0,0 +1,5 @@
+# Disks can be imported by UUID
+terraform import warren_disk.disk42 12345678-9abc-def0-1234-56789abcdef0
+
+# or with the location slug prefixed
+terraform import warren_disk.disk42 cyc01/12345678-9abc-def0-1234-56789abcdef0
@@ -2,3 +2,13 @@ resource "warren_disk" "disk42" {
   server_uuid = resource.warren_virtual_machine.server42.id
   size_in_gb  = 20
 }
+
+resource "warren_disk" "disk42_clone" {
+  clone_from_disk_uuid = resource.warren_disk.disk42.id
+  size_in_gb           = resource.warren_disk.disk42.size_in_gb
+}
+
+resource "warren_disk" "disk42_external" {
+  external_image_url = "https://images.example.com/disk42.qcow2"
+  size_in_gb         = 20
+}
@@ -0,0 +1,5 @@
+# Disk attachments can be imported by the attached disk UUID
+terraform import warren_disk_attachment.disk42 12345678-9abc-def0-1234-56789abcdef0
+
+# or with the location slug prefixed
+terraform import warren_disk_attachment.disk42 cyc01/12345678-9abc-def0-1234-56789abcdef0
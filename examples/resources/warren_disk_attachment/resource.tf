@@ -0,0 +1,8 @@
+resource "warren_disk" "disk42" {
+  size_in_gb = 20
+}
+
+resource "warren_disk_attachment" "disk42" {
+  disk_uuid   = resource.warren_disk.disk42.id
+  server_uuid = resource.warren_virtual_machine.server42.id
+}
@@ -0,0 +1,5 @@
+# Disk snapshots can be imported by UUID
+terraform import warren_disk_snapshot.snapshot42 23456789-abcd-4ef0-1234-56789abcdef1
+
+# or with the location slug prefixed
+terraform import warren_disk_snapshot.snapshot42 cyc01/23456789-abcd-4ef0-1234-56789abcdef1
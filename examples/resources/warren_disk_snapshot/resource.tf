@@ -0,0 +1,9 @@
+resource "warren_disk_snapshot" "snapshot42" {
+  disk_uuid = resource.warren_disk.disk42.id
+}
+
+resource "warren_disk" "disk43" {
+  size_in_gb        = resource.warren_disk_snapshot.snapshot42.size_in_gb
+  source_image_type = "SNAPSHOT"
+  source_image_uuid = resource.warren_disk_snapshot.snapshot42.id
+}
@@ -0,0 +1,5 @@
+# Floating IPs can be imported by address
+terraform import warren_floating_ip.ingress 42.42.42.42
+
+# or by UUID
+terraform import warren_floating_ip.ingress 3456789a-bcde-4012-3f56-789abcdef012
@@ -0,0 +1,8 @@
+# Networks can be imported by UUID
+terraform import warren_network.default_network 23456789-abcd-4f01-23e5-6789abcdef01
+
+# by name
+terraform import warren_network.default_network name:default-network
+
+# or with the location slug prefixed
+terraform import warren_network.default_network cyc01/23456789-abcd-4f01-23e5-6789abcdef01
@@ -0,0 +1,8 @@
+# Virtual machines can be imported by UUID
+terraform import warren_virtual_machine.server42 01234567-89ab-4def-0123-c56789abcdef
+
+# by name
+terraform import warren_virtual_machine.server42 name:server42
+
+# or with the location slug prefixed
+terraform import warren_virtual_machine.server42 cyc01/name:server42
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package provider is the main Terraform provider code package
+package provider
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
+)
+
+var _ = Describe("Cassette", func() {
+	It("replays recorded interactions", func() {
+		cassetteTestEnv := mock.NewCassetteTestEnv("location")
+		defer cassetteTestEnv.Teardown()
+
+		locations, err := cassetteTestEnv.Client.Location.ListLocations()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*locations).To(HaveLen(2))
+		Expect((*locations)[1].DisplayName).To(Equal(mock.TestLocationDisplayName))
+	})
+
+	It("fails for requests not recorded", func() {
+		cassetteTestEnv := mock.NewCassetteTestEnv("location")
+		defer cassetteTestEnv.Teardown()
+
+		_, err := cassetteTestEnv.Client.Location.ListLocations()
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = cassetteTestEnv.Client.Network.ListNetworks()
+		Expect(err).To(MatchError(ContainSubstring("contains no interaction matching request")))
+	})
+
+	It("fails for interactions not replayed", func() {
+		cassetteTestEnv := mock.NewCassetteTestEnv("location")
+
+		Expect(cassetteTestEnv.Cassette.GetUnusedInteractionsError()).To(MatchError(ContainSubstring("contains unused interactions: GET /v1/cyc01/config/locations")))
+		Expect(cassetteTestEnv.Teardown).To(Panic())
+	})
+
+	It("records sanitized interactions", func() {
+		mockTestEnv := mock.NewMockTestEnv()
+		defer mockTestEnv.Teardown()
+
+		mock.SetupVMEndpointOnMux(mockTestEnv.Mux, true)
+
+		cassettePath := filepath.Join(GinkgoT().TempDir(), "cassette.json")
+
+		cassette, err := mock.NewCassetteTransport(mock.CassetteModeRecord, cassettePath, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		client, err := (&warren.ClientBuilder{}).
+			ApiUrl(mockTestEnv.Client.BaseURL.String()).
+			ApiToken("secret-token").
+			LocationSlug("cyc01").
+			Client(&http.Client{ Transport: cassette }).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.VirtualMachine.CreateVirtualMachine(
+			&warren.CreateVirtualMachineRequest{ Name: warren.New("test"), Password: warren.New("secret-password") },
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		jsonData, err := os.ReadFile(cassettePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(jsonData)).To(ContainSubstring("REDACTED"))
+		Expect(string(jsonData)).NotTo(ContainSubstring("secret-password"))
+		Expect(string(jsonData)).NotTo(ContainSubstring("secret-token"))
+	})
+})
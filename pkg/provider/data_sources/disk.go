@@ -0,0 +1,194 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	warrenClient "gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewDisk() datasource.DataSource {
+	return &Disk{}
+}
+
+func (d *Disk) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warrenClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Disk configure error",
+			fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *Disk) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DiskModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disk, err := d.client.BlockStorage.GetDiskById(data.UUID.ValueString())
+	if nil != err {
+		resp.Diagnostics.AddError("Disk read error", apis.GetVolumeErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	var serverUUID string
+
+	server, err := apis.GetServerFromVolumeUUID(d.client, disk.Uuid)
+	if nil != err && !errors.Is(err, apis.ErrServerNotFound) {
+		resp.Diagnostics.AddError("Disk read error", err.Error())
+		return
+	}
+
+	if nil != server {
+		serverUUID = server.Uuid
+	}
+
+	setDiskStateData(disk, serverUUID, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func setDiskStateData(disk *warrenClient.Disk, serverUUID string, data *DiskModel) {
+	data.Attached = types.BoolValue("" != serverUUID)
+	data.BillingAccount = types.Int64Value(int64(disk.BillingAccountId))
+	data.CreatedAt = types.StringValue(disk.CreatedAt)
+	data.ServerUUID = types.StringNull()
+	data.SizeInGB = types.Int64Value(int64(disk.SizeGb))
+	data.Snapshots = warren.DiskSnapshotListValue(disk.Snapshots)
+	data.SourceImageType = types.StringValue(disk.SourceImageType)
+	data.SourceImageUUID = types.StringValue(disk.SourceImage)
+	data.Status = types.StringValue(disk.Status)
+	data.StatusComment = types.StringValue(disk.StatusComment)
+	data.UpdatedAt = types.StringValue(disk.UpdatedAt)
+	data.UserID = types.Int64Value(int64(disk.UserId))
+	data.UUID = types.StringValue(disk.Uuid)
+
+	if "" != serverUUID {
+		data.ServerUUID = types.StringValue(serverUUID)
+	}
+}
+
+func (d *Disk) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk"
+}
+
+func (d *Disk) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warren Platform disk",
+
+		Attributes: getDiskSchemaAttributes(true),
+	}
+}
+
+// getDiskSchemaAttributes returns the schema attributes of a disk.
+//
+// PARAMETERS
+// isLookup bool True if the UUID is required for lookups
+func getDiskSchemaAttributes(isLookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"attached": schema.BoolAttribute{
+			MarkdownDescription: "Disk is attached to a server if set",
+			Computed:            true,
+		},
+		"billing_account": schema.Int64Attribute{
+			MarkdownDescription: "Disk billing account ID",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Disk created at date and time",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Disk UUID",
+			Computed:            !isLookup,
+			Required:            isLookup,
+		},
+		"server_uuid": schema.StringAttribute{
+			MarkdownDescription: "Server UUID disk is attached to",
+			Computed:            true,
+		},
+		"size_in_gb": schema.Int64Attribute{
+			MarkdownDescription: "Disk size in GB",
+			Computed:            true,
+		},
+		"snapshots": schema.ListNestedAttribute{
+			MarkdownDescription: "Disk snapshots",
+			Computed:            true,
+			NestedObject:        schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"created_at": schema.StringAttribute{
+						MarkdownDescription: "Disk snapshot created at date and time",
+						Computed:            true,
+					},
+					"disk_uuid": schema.StringAttribute{
+						MarkdownDescription: "Source disk UUID",
+						Computed:            true,
+					},
+					"size_in_gb": schema.Int64Attribute{
+						MarkdownDescription: "Disk snapshot size",
+						Computed:            true,
+					},
+					"uuid": schema.StringAttribute{
+						MarkdownDescription: "Disk snapshot UUID",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"source_image_type": schema.StringAttribute{
+			MarkdownDescription: "Disk source image type",
+			Computed:            true,
+		},
+		"source_image_uuid": schema.StringAttribute{
+			MarkdownDescription: "Disk source image UUID",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Disk status",
+			Computed:            true,
+		},
+		"status_comment": schema.StringAttribute{
+			MarkdownDescription: "Disk status comment",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "Disk updated at date and time",
+			Computed:            true,
+		},
+		"user_id": schema.Int64Attribute{
+			MarkdownDescription: "Disk owner's user ID",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewDisks() datasource.DataSource {
+	return &Disks{}
+}
+
+func (d *Disks) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warren.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Disks configure error",
+			fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *Disks) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DisksModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disks, err := d.client.BlockStorage.ListUserDisks()
+	if nil != err {
+		resp.Diagnostics.AddError("Disks read error", apis.GetVolumeErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	serverUUIDs, err := apis.GetServerUUIDsByVolumeUUID(d.client)
+	if nil != err {
+		resp.Diagnostics.AddError("Disks read error", err.Error())
+		return
+	}
+
+	data.Disks = []DiskModel{}
+
+	for index := range *disks {
+		disk := &(*disks)[index]
+		serverUUID := serverUUIDs[disk.Uuid]
+
+		if !data.Status.IsNull() && data.Status.ValueString() != disk.Status {
+			continue
+		}
+
+		if !data.SourceImageType.IsNull() && data.SourceImageType.ValueString() != disk.SourceImageType {
+			continue
+		}
+
+		if !data.Attached.IsNull() && data.Attached.ValueBool() != ("" != serverUUID) {
+			continue
+		}
+
+		if !data.MinSizeInGB.IsNull() && data.MinSizeInGB.ValueInt64() > int64(disk.SizeGb) {
+			continue
+		}
+
+		if !data.MaxSizeInGB.IsNull() && data.MaxSizeInGB.ValueInt64() < int64(disk.SizeGb) {
+			continue
+		}
+
+		diskData := DiskModel{}
+		setDiskStateData(disk, serverUUID, &diskData)
+
+		data.Disks = append(data.Disks, diskData)
+	}
+
+	data.ID = types.StringValue(getFilterID("disks", map[string]attr.Value{
+		"attached":          data.Attached,
+		"max_size_in_gb":    data.MaxSizeInGB,
+		"min_size_in_gb":    data.MinSizeInGB,
+		"source_image_type": data.SourceImageType,
+		"status":            data.Status,
+	}))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *Disks) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disks"
+}
+
+func (d *Disks) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warren Platform disks",
+
+		Attributes: map[string]schema.Attribute{
+			"attached": schema.BoolAttribute{
+				MarkdownDescription: "Disk attachment state to filter for",
+				Optional:            true,
+			},
+			"disks": schema.ListNestedAttribute{
+				MarkdownDescription: "Disks matching all filters given",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: getDiskSchemaAttributes(false),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Disks identifier built of the filters configured",
+				Computed:            true,
+			},
+			"max_size_in_gb": schema.Int64Attribute{
+				MarkdownDescription: "Maximum disk size in GB to filter for",
+				Optional:            true,
+			},
+			"min_size_in_gb": schema.Int64Attribute{
+				MarkdownDescription: "Minimum disk size in GB to filter for",
+				Optional:            true,
+			},
+			"source_image_type": schema.StringAttribute{
+				MarkdownDescription: "Disk source image type to filter for",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Disk status to filter for",
+				Optional:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
+)
+
+func generateDisksConfig(mockTestEnv mock.MockTestEnv, filters string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+data "warren_disks" "test" {
+	%s
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		filters,
+	)
+}
+
+func DisksTest(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
+	var mockTestEnv mock.MockTestEnv
+	t := GinkgoT()
+
+	var _ = BeforeEach(func() {
+		mockTestEnv = mock.NewMockTestEnv()
+
+		apis.SetClientForToken("dummy-token", mockTestEnv.Client)
+		mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, false)
+		mock.SetupVMEndpointOnMux(mockTestEnv.Mux, false)
+	})
+
+	var _ = AfterEach(func() {
+		mockTestEnv.Teardown()
+		apis.SetClientForToken("dummy-token", nil)
+	})
+
+	var _ = Describe("Disks", func() {
+		DescribeTable(
+			"are correctly filtered",
+			func(filters string, expectedCount string) {
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps: []resource.TestStep{
+							// Read testing
+							{
+								Config: generateDisksConfig(mockTestEnv, filters),
+								Check:  resource.ComposeAggregateTestCheckFunc(
+									resource.TestCheckResourceAttr("data.warren_disks.test", "disks.#", expectedCount),
+								),
+							},
+						},
+					},
+				)
+			},
+			Entry("without filters", "", "1"),
+			Entry("by status", `status = "Active"`, "1"),
+			Entry("by source image type", `source_image_type = "SNAPSHOT"`, "0"),
+			Entry("by attached disks", "attached = true", "1"),
+			Entry("by unattached disks", "attached = false", "0"),
+			Entry("by size range", "min_size_in_gb = 10\n\tmax_size_in_gb = 20", "1"),
+			Entry("by too small size range", "max_size_in_gb = 10", "0"),
+		)
+
+		Expect(t.Failed()).To(BeFalse())
+	})
+}
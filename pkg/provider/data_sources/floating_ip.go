@@ -0,0 +1,306 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewFloatingIP() datasource.DataSource {
+	return &FloatingIP{}
+}
+
+func (d *FloatingIP) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warren.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Floating IP configure error",
+			fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FloatingIP) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("address"),
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("name_regex"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("name"),
+			path.MatchRoot("name_regex"),
+		),
+	}
+}
+
+func (d *FloatingIP) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FloatingIPModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var address net.IP
+
+	if !data.Address.IsNull() {
+		address = net.ParseIP(data.Address.ValueString())
+
+		if nil == address {
+			resp.Diagnostics.AddError("Floating IP read error", fmt.Sprintf("Invalid IP address: %s", data.Address.ValueString()))
+			return
+		}
+	}
+
+	nameRegex, err := getLookupRegex(data.NameRegex)
+	if nil != err {
+		resp.Diagnostics.AddError("Floating IP read error", err.Error())
+		return
+	}
+
+	floatingIPs, err := d.client.Network.ListFloatingIps()
+	if nil != err {
+		resp.Diagnostics.AddError("Floating IP read error", apis.GetFloatingIPErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	var (
+		candidates []string
+		match      *warren.FloatingIp
+	)
+
+	for index := range *floatingIPs {
+		floatingIP := &(*floatingIPs)[index]
+
+		if !isFloatingIPMatching(floatingIP, &data, address, nameRegex) {
+			continue
+		}
+
+		candidates = append(candidates, floatingIP.Uuid)
+		match = floatingIP
+	}
+
+	err = getLookupError(candidates, apis.ErrFloatingIPNotFound, "floating IPs", d.getCriteriaString(&data))
+	if nil != err {
+		resp.Diagnostics.AddError("Floating IP read error", err.Error())
+		return
+	}
+
+	var networkUUID string
+
+	if "" != match.AssignedTo {
+		network, err := apis.GetNetworkFromServerUUID(d.client, match.AssignedTo)
+		// Floating IPs may be assigned to resources other than virtual machines
+		if nil != err && !errors.Is(err, apis.ErrServerNotFound) && !errors.Is(err, apis.ErrNetworkNotFound) {
+			resp.Diagnostics.AddError("Floating IP read error", err.Error())
+			return
+		}
+
+		if nil != network {
+			networkUUID = network.Uuid
+		}
+	}
+
+	setFloatingIPStateData(match, networkUUID, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *FloatingIP) getCriteriaString(data *FloatingIPModel) string {
+	var criteria []string
+
+	if !data.Address.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("address %s", data.Address.ValueString()))
+	}
+
+	if !data.ID.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("UUID %s", data.ID.ValueString()))
+	}
+
+	if !data.Name.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("name %s", data.Name.ValueString()))
+	}
+
+	if !data.NameRegex.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("name regular expression %s", data.NameRegex.ValueString()))
+	}
+
+	return strings.Join(criteria, " and ")
+}
+
+// isFloatingIPMatching returns true if the floating IP given matches all
+// lookup criteria given.
+//
+// PARAMETERS
+// floatingIP *warren.FloatingIp Floating IP to check
+// data       *FloatingIPModel   Lookup criteria
+// address    net.IP             Parsed floating IP address or nil
+// nameRegex  *regexp.Regexp     Name regular expression or nil
+func isFloatingIPMatching(floatingIP *warren.FloatingIp, data *FloatingIPModel, address net.IP, nameRegex *regexp.Regexp) bool {
+	if !floatingIP.Enabled {
+		return false
+	}
+
+	if nil != address && !address.Equal(net.ParseIP(floatingIP.Address)) {
+		return false
+	}
+
+	if !data.ID.IsNull() && data.ID.ValueString() != floatingIP.Uuid {
+		return false
+	}
+
+	if !data.Name.IsNull() && data.Name.ValueString() != floatingIP.Name {
+		return false
+	}
+
+	if nil != nameRegex && !nameRegex.MatchString(floatingIP.Name) {
+		return false
+	}
+
+	return true
+}
+
+func setFloatingIPStateData(floatingIP *warren.FloatingIp, networkUUID string, data *FloatingIPModel) {
+	data.Address = types.StringValue(floatingIP.Address)
+	data.AssignedTo = types.StringValue(floatingIP.AssignedTo)
+	data.AssignedToPrivateIP = types.StringValue(floatingIP.AssignedToPrivateIp)
+	data.AssignedToResourceType = types.StringValue(floatingIP.AssignedToResourceType)
+	data.BillingAccount = types.Int64Value(int64(floatingIP.BillingAccountId))
+	data.CreatedAt = types.StringValue(floatingIP.CreatedAt)
+	data.Enabled = types.BoolValue(floatingIP.Enabled)
+	data.ID = types.StringValue(floatingIP.Uuid)
+	data.IsIPv6 = types.BoolValue(floatingIP.IsIPv6)
+	data.Name = types.StringValue(floatingIP.Name)
+	data.NetworkUUID = types.StringNull()
+	data.Type = types.StringValue(floatingIP.Type)
+	data.UpdatedAt = types.StringValue(floatingIP.UpdatedAt)
+	data.UserID = types.Int64Value(int64(floatingIP.UserId))
+
+	if "" != networkUUID {
+		data.NetworkUUID = types.StringValue(networkUUID)
+	}
+}
+
+func (d *FloatingIP) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_floating_ip"
+}
+
+func (d *FloatingIP) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warren Platform floating IP. Disabled floating IPs are not matched.",
+
+		Attributes: getFloatingIPSchemaAttributes(true),
+	}
+}
+
+// getFloatingIPSchemaAttributes returns the schema attributes of a floating
+// IP.
+//
+// PARAMETERS
+// isLookup bool True if the address, UUID and name are used for lookups
+func getFloatingIPSchemaAttributes(isLookup bool) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"address": schema.StringAttribute{
+			MarkdownDescription: "Floating IP address",
+			Computed:            true,
+			Optional:            isLookup,
+		},
+		"assigned_to": schema.StringAttribute{
+			MarkdownDescription: "UUID of the resource the floating IP is assigned to",
+			Computed:            true,
+		},
+		"assigned_to_private_ip": schema.StringAttribute{
+			MarkdownDescription: "Private IP of the resource the floating IP is assigned to",
+			Computed:            true,
+		},
+		"assigned_to_resource_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the resource the floating IP is assigned to",
+			Computed:            true,
+		},
+		"billing_account": schema.Int64Attribute{
+			MarkdownDescription: "Floating IP billing account ID",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Floating IP created at date and time",
+			Computed:            true,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Value if the floating IP is enabled",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Floating IP UUID",
+			Computed:            true,
+			Optional:            isLookup,
+		},
+		"is_ipv6": schema.BoolAttribute{
+			MarkdownDescription: "True if the floating IP is an IPv6 address",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Floating IP name",
+			Computed:            true,
+			Optional:            isLookup,
+		},
+		"network_uuid": schema.StringAttribute{
+			MarkdownDescription: "Network UUID the floating IP is routed to",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Floating IP type",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "Floating IP updated at date and time",
+			Computed:            true,
+		},
+		"user_id": schema.Int64Attribute{
+			MarkdownDescription: "Floating IP owner's user ID",
+			Computed:            true,
+		},
+	}
+
+	if isLookup {
+		attributes["name_regex"] = schema.StringAttribute{
+			MarkdownDescription: "Floating IP name regular expression to look up",
+			Optional:            true,
+		}
+	}
+
+	return attributes
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewFloatingIPs() datasource.DataSource {
+	return &FloatingIPs{}
+}
+
+func (d *FloatingIPs) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warren.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Floating IPs configure error",
+			fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FloatingIPs) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FloatingIPsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	floatingIPs, err := d.client.Network.ListFloatingIps()
+	if nil != err {
+		resp.Diagnostics.AddError("Floating IPs read error", apis.GetFloatingIPErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	var networkUUIDs map[string]string
+
+	data.FloatingIPs = []FloatingIPsFloatingIPModel{}
+
+	for index := range *floatingIPs {
+		floatingIP := &(*floatingIPs)[index]
+
+		if !floatingIP.Enabled {
+			continue
+		}
+
+		if !data.Assigned.IsNull() && data.Assigned.ValueBool() != ("" != floatingIP.AssignedTo) {
+			continue
+		}
+
+		if !data.AssignedToResourceType.IsNull() && data.AssignedToResourceType.ValueString() != floatingIP.AssignedToResourceType {
+			continue
+		}
+
+		if !data.IsIPv6.IsNull() && data.IsIPv6.ValueBool() != floatingIP.IsIPv6 {
+			continue
+		}
+
+		if !data.Name.IsNull() && data.Name.ValueString() != floatingIP.Name {
+			continue
+		}
+
+		// Networks are only looked up once if any floating IP is assigned
+		if "" != floatingIP.AssignedTo && nil == networkUUIDs {
+			networkUUIDs, err = apis.GetNetworkUUIDsByServerUUID(d.client)
+			if nil != err {
+				resp.Diagnostics.AddError("Floating IPs read error", err.Error())
+				return
+			}
+		}
+
+		floatingIPData := FloatingIPModel{}
+		setFloatingIPStateData(floatingIP, networkUUIDs[floatingIP.AssignedTo], &floatingIPData)
+
+		data.FloatingIPs = append(data.FloatingIPs, getFloatingIPsFloatingIPModel(&floatingIPData))
+	}
+
+	data.ID = types.StringValue(getFilterID("floating_ips", map[string]attr.Value{
+		"assigned":                  data.Assigned,
+		"assigned_to_resource_type": data.AssignedToResourceType,
+		"is_ipv6":                   data.IsIPv6,
+		"name":                      data.Name,
+	}))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getFloatingIPsFloatingIPModel returns the floating IPs list model of the
+// given floating IP data.
+//
+// PARAMETERS
+// data *FloatingIPModel Floating IP data
+func getFloatingIPsFloatingIPModel(data *FloatingIPModel) FloatingIPsFloatingIPModel {
+	return FloatingIPsFloatingIPModel{
+		Address:                data.Address,
+		AssignedTo:             data.AssignedTo,
+		AssignedToPrivateIP:    data.AssignedToPrivateIP,
+		AssignedToResourceType: data.AssignedToResourceType,
+		BillingAccount:         data.BillingAccount,
+		CreatedAt:              data.CreatedAt,
+		Enabled:                data.Enabled,
+		ID:                     data.ID,
+		IsIPv6:                 data.IsIPv6,
+		Name:                   data.Name,
+		NetworkUUID:            data.NetworkUUID,
+		Type:                   data.Type,
+		UpdatedAt:              data.UpdatedAt,
+		UserID:                 data.UserID,
+	}
+}
+
+func (d *FloatingIPs) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_floating_ips"
+}
+
+func (d *FloatingIPs) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warren Platform floating IPs. Disabled floating IPs are not listed.",
+
+		Attributes: map[string]schema.Attribute{
+			"assigned": schema.BoolAttribute{
+				MarkdownDescription: "Floating IP assignment state to filter for",
+				Optional:            true,
+			},
+			"assigned_to_resource_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the resource the floating IP is assigned to to filter for",
+				Optional:            true,
+			},
+			"floating_ips": schema.ListNestedAttribute{
+				MarkdownDescription: "Floating IPs matching all filters given",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: getFloatingIPSchemaAttributes(false),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Floating IPs identifier built of the filters configured",
+				Computed:            true,
+			},
+			"is_ipv6": schema.BoolAttribute{
+				MarkdownDescription: "Floating IP IPv6 state to filter for",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Floating IP name to filter for",
+				Optional:            true,
+			},
+		},
+	}
+}
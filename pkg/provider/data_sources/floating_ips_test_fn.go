@@ -0,0 +1,85 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
+)
+
+func generateFloatingIPsConfig(mockTestEnv mock.MockTestEnv, filters string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+data "warren_floating_ips" "test" {
+	%s
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		filters,
+	)
+}
+
+func FloatingIPsTest(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
+	var mockTestEnv mock.MockTestEnv
+	t := GinkgoT()
+
+	var _ = BeforeEach(func() {
+		mockTestEnv = mock.NewMockTestEnv()
+
+		apis.SetClientForToken("dummy-token", mockTestEnv.Client)
+		mock.SetupIPAddressesEndpointOnMux(mockTestEnv.Mux, false)
+		mock.SetupNetworkEndpointOnMux(mockTestEnv.Mux, false)
+		mock.SetupVMEndpointOnMux(mockTestEnv.Mux, false)
+	})
+
+	var _ = AfterEach(func() {
+		mockTestEnv.Teardown()
+		apis.SetClientForToken("dummy-token", nil)
+	})
+
+	var _ = Describe("Floating IPs", func() {
+		DescribeTable(
+			"are correctly filtered",
+			func(filters string, expectedCount string) {
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps: []resource.TestStep{
+							// Read testing
+							{
+								Config: generateFloatingIPsConfig(mockTestEnv, filters),
+								Check:  resource.ComposeAggregateTestCheckFunc(
+									resource.TestCheckResourceAttr("data.warren_floating_ips.test", "floating_ips.#", expectedCount),
+								),
+							},
+						},
+					},
+				)
+			},
+			Entry("without filters", "", "1"),
+			Entry("by assigned floating IPs", "assigned = true", "1"),
+			Entry("by unassigned floating IPs", "assigned = false", "0"),
+			Entry("by resource type", `assigned_to_resource_type = "virtual_machine"`, "1"),
+			Entry("by IPv6", "is_ipv6 = true", "0"),
+			Entry("by name", `name = "test"`, "1"),
+		)
+
+		Expect(t.Failed()).To(BeFalse())
+	})
+}
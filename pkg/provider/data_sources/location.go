@@ -12,6 +12,8 @@ package data_sources
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -48,10 +50,21 @@ func (d *Location) Configure(ctx context.Context, req datasource.ConfigureReques
 
 func (d *Location) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
 	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("display_name"),
+			path.MatchRoot("display_name_regex"),
+			path.MatchRoot("id"),
+			path.MatchRoot("is_default"),
+			path.MatchRoot("is_preferred"),
+		),
 		datasourcevalidator.Conflicting(
 			path.MatchRoot("display_name"),
 			path.MatchRoot("id"),
 		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("display_name"),
+			path.MatchRoot("display_name_regex"),
+		),
 	}
 }
 
@@ -64,93 +77,111 @@ func (d *Location) Read(ctx context.Context, req datasource.ReadRequest, resp *d
 		return
 	}
 
+	displayNameRegex, err := getLookupRegex(data.DisplayNameRegex)
+	if nil != err {
+		resp.Diagnostics.AddError("Location read error", err.Error())
+		return
+	}
+
 	locations, err := d.client.Location.ListLocations()
 	if nil != err {
 		resp.Diagnostics.AddError("Location read error", apis.GetLocationErrorFromHttpCallError(err).Error())
 		return
 	}
 
-	var isFound bool
-	isParameterOnly := data.DisplayName.IsNull() && data.Slug.IsNull()
+	var (
+		candidates []string
+		match      *warren.Location
+	)
 
-	for _, location := range *locations {
-		if !data.DisplayName.IsNull() && data.DisplayName.Equal(types.StringValue(location.DisplayName)) {
-			isFound = true
-		}
+	for index := range *locations {
+		location := &(*locations)[index]
 
-		if !data.Slug.IsNull() && data.Slug.Equal(types.StringValue(location.Slug)) {
-			isFound = true
+		if !isLocationMatching(location, &data, displayNameRegex) {
+			continue
 		}
 
-		if !data.IsDefault.IsNull() {
-			if !data.IsDefault.Equal(types.BoolValue(location.IsDefault)) {
-				isFound = false
-			} else if isParameterOnly {
-				isFound = true
-			}
-		}
+		candidates = append(candidates, location.Slug)
+		match = location
+	}
 
-		if !data.IsPreferred.IsNull() {
-			if !data.IsPreferred.Equal(types.BoolValue(location.IsPreferred)) {
-				isFound = false
-			} else if isParameterOnly {
-				isFound = true
-			}
-		}
+	err = getLookupError(candidates, apis.ErrLocationNotFound, "locations", d.getCriteriaString(&data))
+	if nil != err {
+		resp.Diagnostics.AddError("Location read error", err.Error())
+		return
+	}
 
-		if !isFound {
-			continue
-		}
+	setLocationStateData(match, &data)
 
-		data.CountryCode = types.StringValue(location.CountryCode)
-		data.DisplayName = types.StringValue(location.DisplayName)
-		data.IsDefault = types.BoolValue(location.IsDefault)
-		data.IsPreferred = types.BoolValue(location.IsPreferred)
-		data.Slug = types.StringValue(location.Slug)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
-		if isFound {
-			break
-		}
+func (d *Location) getCriteriaString(data *LocationModel) string {
+	var criteria []string
+
+	if !data.DisplayName.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("display name %s", data.DisplayName.ValueString()))
 	}
 
-	if !isFound {
-		var parameters string
+	if !data.DisplayNameRegex.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("display name regular expression %s", data.DisplayNameRegex.ValueString()))
+	}
 
-		if !data.IsDefault.IsNull() {
-			parameters = fmt.Sprintf("Default %s", data.IsDefault.String())
-		}
+	if !data.Slug.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("slug %s", data.Slug.ValueString()))
+	}
 
-		if !data.IsPreferred.IsNull() {
-			if "" != parameters {
-				parameters += ", "
-			}
+	if !data.IsDefault.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("default %s", data.IsDefault.String()))
+	}
 
-			parameters += fmt.Sprintf("Preferred %s", data.IsPreferred.String())
-		}
+	if !data.IsPreferred.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("preferred %s", data.IsPreferred.String()))
+	}
 
-		if "" != parameters {
-			parameters = fmt.Sprintf(" (%s)", parameters)
-		}
+	return strings.Join(criteria, " and ")
+}
 
-		if !data.DisplayName.IsNull() {
-			resp.Diagnostics.AddError(
-				"Location read error",
-				fmt.Sprintf("No match found for display name: %s%s", data.DisplayName.ValueString(), parameters),
-			)
-		}
+// isLocationMatching returns true if the location given matches all lookup
+// criteria given.
+//
+// PARAMETERS
+// location         *warren.Location Location to check
+// data             *LocationModel   Lookup criteria
+// displayNameRegex *regexp.Regexp   Display name regular expression or nil
+func isLocationMatching(location *warren.Location, data *LocationModel, displayNameRegex *regexp.Regexp) bool {
+	if !data.DisplayName.IsNull() && data.DisplayName.ValueString() != location.DisplayName {
+		return false
+	}
 
-		if !data.Slug.IsNull() {
-			resp.Diagnostics.AddError(
-				"Location read error",
-				fmt.Sprintf("No match found for slug: %s%s", data.Slug.ValueString(), parameters),
-			)
-		}
+	if nil != displayNameRegex && !displayNameRegex.MatchString(location.DisplayName) {
+		return false
+	}
 
-		return
+	if !data.Slug.IsNull() && data.Slug.ValueString() != location.Slug {
+		return false
 	}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if !data.IsDefault.IsNull() && data.IsDefault.ValueBool() != location.IsDefault {
+		return false
+	}
+
+	if !data.IsPreferred.IsNull() && data.IsPreferred.ValueBool() != location.IsPreferred {
+		return false
+	}
+
+	return true
+}
+
+func setLocationStateData(location *warren.Location, data *LocationModel) {
+	data.CountryCode = types.StringValue(location.CountryCode)
+	data.Description = types.StringValue(location.Description)
+	data.DisplayName = types.StringValue(location.DisplayName)
+	data.IsDefault = types.BoolValue(location.IsDefault)
+	data.IsPreferred = types.BoolValue(location.IsPreferred)
+	data.OrderNr = types.Int64Value(int64(location.OrderNr))
+	data.Slug = types.StringValue(location.Slug)
 }
 
 func (d *Location) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -161,35 +192,56 @@ func (d *Location) Schema(ctx context.Context, req datasource.SchemaRequest, res
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Warren Platform location",
 
-		Attributes: map[string]schema.Attribute{
-			"country_code": schema.StringAttribute{
-				MarkdownDescription: "Location country code",
-				Computed:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Location description",
-				Computed:            true,
-			},
-			"display_name": schema.StringAttribute{
-				MarkdownDescription: "Location display name",
-				Computed:            true,
-				Optional:            true,
-			},
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Location slug",
-				Computed:            true,
-				Optional:            true,
-			},
-			"is_default": schema.BoolAttribute{
-				MarkdownDescription: "Location set as default",
-				Computed:            true,
-				Optional:            true,
-			},
-			"is_preferred": schema.BoolAttribute{
-				MarkdownDescription: "Location set as preferred",
-				Computed:            true,
-				Optional:            true,
-			},
+		Attributes: getLocationSchemaAttributes(true),
+	}
+}
+
+// getLocationSchemaAttributes returns the schema attributes of a location.
+//
+// PARAMETERS
+// isLookup bool True if the display name, slug and flags are used for lookups
+func getLocationSchemaAttributes(isLookup bool) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"country_code": schema.StringAttribute{
+			MarkdownDescription: "Location country code",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Location description",
+			Computed:            true,
+		},
+		"display_name": schema.StringAttribute{
+			MarkdownDescription: "Location display name",
+			Computed:            true,
+			Optional:            isLookup,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Location slug",
+			Computed:            true,
+			Optional:            isLookup,
+		},
+		"is_default": schema.BoolAttribute{
+			MarkdownDescription: "Location set as default",
+			Computed:            true,
+			Optional:            isLookup,
+		},
+		"is_preferred": schema.BoolAttribute{
+			MarkdownDescription: "Location set as preferred",
+			Computed:            true,
+			Optional:            isLookup,
+		},
+		"order_nr": schema.Int64Attribute{
+			MarkdownDescription: "Location order number",
+			Computed:            true,
 		},
 	}
+
+	if isLookup {
+		attributes["display_name_regex"] = schema.StringAttribute{
+			MarkdownDescription: "Location display name regular expression to look up",
+			Optional:            true,
+		}
+	}
+
+	return attributes
 }
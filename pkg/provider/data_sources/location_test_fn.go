@@ -11,6 +11,7 @@ package data_sources
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -34,6 +35,20 @@ data "warren_location" "test" {
 	)
 }
 
+func generateLocationLookupConfig(mockTestEnv mock.MockTestEnv, lookup string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+data "warren_location" "test" {
+	%s
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		lookup,
+	)
+}
+
 func LocationTest(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
 	var mockTestEnv mock.MockTestEnv
 	t := GinkgoT()
@@ -69,6 +84,59 @@ func LocationTest(providerFactories map[string]func() (tfprotov6.ProviderServer,
 			)
 		})
 
+		DescribeTable(
+			"is correctly looked up",
+			func(lookup string, expectedSlug string, expectedError string) {
+				step := resource.TestStep{
+					Config: generateLocationLookupConfig(mockTestEnv, lookup),
+					Check:  resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.warren_location.test", "id", expectedSlug),
+					),
+				}
+
+				if "" != expectedError {
+					step.Check = nil
+					step.ExpectError = regexp.MustCompile(expectedError)
+				}
+
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps:                    []resource.TestStep{step},
+					},
+				)
+			},
+			Entry("by display name", fmt.Sprintf("display_name = %q", mock.TestLocationDisplayName), "cyc01", ""),
+			Entry("by display name regular expression", `display_name_regex = "^Pedal"`, "ped01", ""),
+			Entry("by default state", "is_default = true", "cyc01", ""),
+			Entry("by preferred state", "is_preferred = true", "ped01", ""),
+			Entry("by slug and default state", "id = \"cyc01\"\n\tis_default = true", "cyc01", ""),
+			Entry("by slug and unmatched preferred state", "id = \"cyc01\"\n\tis_preferred = true", "", "No match found for slug cyc01 and preferred true"),
+			Entry("by ambiguous display name regular expression", `display_name_regex = "town$"`, "", "(?s)Multiple locations found.*ped01,.*cyc01"),
+		)
+
+		It("is correctly read from a cassette fixture", func() {
+			cassetteTestEnv := mock.NewCassetteTestEnv("location")
+			defer cassetteTestEnv.Teardown()
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Read testing
+						{
+							Config: generateLocationConfig(cassetteTestEnv, "cyc01"),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("data.warren_location.test", "display_name", mock.TestLocationDisplayName),
+							),
+						},
+					},
+				},
+			)
+		})
+
 		Expect(t.Failed()).To(BeFalse())
 	})
 }
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewLocations() datasource.DataSource {
+	return &Locations{}
+}
+
+func (d *Locations) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warren.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Locations configure error",
+			fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *Locations) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LocationsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locations, err := d.client.Location.ListLocations()
+	if nil != err {
+		resp.Diagnostics.AddError("Locations read error", apis.GetLocationErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	sort.SliceStable(*locations, func(i, j int) bool {
+		return (*locations)[i].OrderNr < (*locations)[j].OrderNr
+	})
+
+	data.Locations = []LocationsLocationModel{}
+
+	for index := range *locations {
+		location := &(*locations)[index]
+
+		if !data.CountryCode.IsNull() && !strings.EqualFold(data.CountryCode.ValueString(), location.CountryCode) {
+			continue
+		}
+
+		locationData := LocationModel{}
+		setLocationStateData(location, &locationData)
+
+		data.Locations = append(data.Locations, getLocationsLocationModel(&locationData))
+	}
+
+	data.ID = types.StringValue(getFilterID("locations", map[string]attr.Value{
+		"country_code": data.CountryCode,
+	}))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getLocationsLocationModel returns the locations list model of the given
+// location data.
+//
+// PARAMETERS
+// data *LocationModel Location data
+func getLocationsLocationModel(data *LocationModel) LocationsLocationModel {
+	return LocationsLocationModel{
+		CountryCode: data.CountryCode,
+		Description: data.Description,
+		DisplayName: data.DisplayName,
+		IsDefault:   data.IsDefault,
+		IsPreferred: data.IsPreferred,
+		OrderNr:     data.OrderNr,
+		Slug:        data.Slug,
+	}
+}
+
+func (d *Locations) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_locations"
+}
+
+func (d *Locations) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warren Platform locations",
+
+		Attributes: map[string]schema.Attribute{
+			"country_code": schema.StringAttribute{
+				MarkdownDescription: "Location country code to filter for",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Locations identifier built of the filters configured",
+				Computed:            true,
+			},
+			"locations": schema.ListNestedAttribute{
+				MarkdownDescription: "Locations matching all filters given ordered by their order number",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: getLocationSchemaAttributes(false),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
+)
+
+func generateLocationsConfig(mockTestEnv mock.MockTestEnv, filters string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+data "warren_locations" "test" {
+	%s
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		filters,
+	)
+}
+
+func LocationsTest(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
+	var mockTestEnv mock.MockTestEnv
+	t := GinkgoT()
+
+	var _ = BeforeEach(func() {
+		mockTestEnv = mock.NewMockTestEnv()
+
+		apis.SetClientForToken("dummy-token", mockTestEnv.Client)
+		mock.SetupLocationEndpointOnMux(mockTestEnv.Mux)
+	})
+
+	var _ = AfterEach(func() {
+		mockTestEnv.Teardown()
+		apis.SetClientForToken("dummy-token", nil)
+	})
+
+	var _ = Describe("Locations", func() {
+		DescribeTable(
+			"are correctly filtered",
+			func(filters string, expectedCount string) {
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps: []resource.TestStep{
+							// Read testing
+							{
+								Config: generateLocationsConfig(mockTestEnv, filters),
+								Check:  resource.ComposeAggregateTestCheckFunc(
+									resource.TestCheckResourceAttr("data.warren_locations.test", "locations.#", expectedCount),
+								),
+							},
+						},
+					},
+				)
+			},
+			Entry("without filters", "", "2"),
+			Entry("by country code", `country_code = "EST"`, "1"),
+			Entry("by unknown country code", `country_code = "fin"`, "0"),
+		)
+
+		It("are ordered by order number", func() {
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Read testing
+						{
+							Config: generateLocationsConfig(mockTestEnv, ""),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("data.warren_locations.test", "locations.0.id", "cyc01"),
+								resource.TestCheckResourceAttr("data.warren_locations.test", "locations.0.description", "The original location"),
+								resource.TestCheckResourceAttr("data.warren_locations.test", "locations.0.order_nr", "1"),
+								resource.TestCheckResourceAttr("data.warren_locations.test", "locations.1.id", "ped01"),
+							),
+						},
+					},
+				},
+			)
+		})
+
+		Expect(t.Failed()).To(BeFalse())
+	})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+// getLookupError returns an error if not exactly one candidate matches all
+// lookup criteria given.
+//
+// PARAMETERS
+// candidates  []string Identifiers of all candidates matching
+// notFoundErr error    Error wrapped if no candidate matches
+// typeName    string   Plural type name of the candidates
+// criteria    string   Lookup criteria description
+func getLookupError(candidates []string, notFoundErr error, typeName string, criteria string) error {
+	if 0 == len(candidates) {
+		return fmt.Errorf("%w: No match found for %s", notFoundErr, criteria)
+	}
+
+	if len(candidates) > 1 {
+		return fmt.Errorf(
+			"%w: Multiple %s found for %s: %s",
+			apis.ErrAmbiguousMatch,
+			typeName,
+			criteria,
+			strings.Join(candidates, ", "),
+		)
+	}
+
+	return nil
+}
+
+// getLookupRegex returns the compiled regular expression of the lookup
+// attribute value given or nil if it is null.
+//
+// PARAMETERS
+// value types.String Regular expression attribute value
+func getLookupRegex(value types.String) (*regexp.Regexp, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	lookupRegex, err := regexp.Compile(value.ValueString())
+	if nil != err {
+		return nil, fmt.Errorf("Invalid regular expression: %s", err.Error())
+	}
+
+	return lookupRegex, nil
+}
+
+// getFilterID returns a stable identifier of a list data source built of its
+// type name and all filter values configured.
+//
+// PARAMETERS
+// typeName string                Plural type name of the data source
+// filters  map[string]attr.Value Filter attribute values by attribute name
+func getFilterID(typeName string, filters map[string]attr.Value) string {
+	filterValues := url.Values{}
+
+	for name, value := range filters {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if stringValue, ok := value.(types.String); ok {
+			filterValues.Set(name, stringValue.ValueString())
+		} else {
+			filterValues.Set(name, value.String())
+		}
+	}
+
+	if 0 == len(filterValues) {
+		return typeName
+	}
+
+	return fmt.Sprintf("%s/%s", typeName, filterValues.Encode())
+}
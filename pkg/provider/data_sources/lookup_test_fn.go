@@ -0,0 +1,77 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func LookupTest() {
+	var _ = Describe("Lookup", func() {
+		DescribeTable(
+			"requires exactly one candidate",
+			func(candidates []string, expectedErr error, expectedMessage string) {
+				err := getLookupError(candidates, apis.ErrNetworkNotFound, "networks", "name test")
+
+				if nil == expectedErr {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(err.Error()).To(Equal(expectedMessage))
+				}
+			},
+			Entry("without candidates", []string{}, apis.ErrNetworkNotFound, "Network not found: No match found for name test"),
+			Entry("with a single candidate", []string{"a"}, nil, ""),
+			Entry("with multiple candidates", []string{"a", "b"}, apis.ErrAmbiguousMatch, "Multiple matches found: Multiple networks found for name test: a, b"),
+		)
+
+		DescribeTable(
+			"compiles optional regular expressions",
+			func(value types.String, isNil bool, isErr bool) {
+				lookupRegex, err := getLookupRegex(value)
+
+				Expect(nil == lookupRegex).To(Equal(isNil))
+				Expect(nil != err).To(Equal(isErr))
+			},
+			Entry("without a value", types.StringNull(), true, false),
+			Entry("with a valid value", types.StringValue("^test-\\d+$"), false, false),
+			Entry("with an invalid value", types.StringValue("("), true, true),
+		)
+
+		DescribeTable(
+			"builds list identifiers of the filters configured",
+			func(filters map[string]attr.Value, expectedID string) {
+				Expect(getFilterID("disks", filters)).To(Equal(expectedID))
+			},
+			Entry("without filters", map[string]attr.Value{}, "disks"),
+			Entry("with null filters", map[string]attr.Value{ "status": types.StringNull() }, "disks"),
+			Entry(
+				"with filters",
+				map[string]attr.Value{
+					"attached":       types.BoolValue(true),
+					"min_size_in_gb": types.Int64Value(10),
+					"status":         types.StringValue("Active"),
+				},
+				"disks/attached=true&min_size_in_gb=10&status=Active",
+			),
+			Entry("with escaped filter values", map[string]attr.Value{ "status": types.StringValue("a&b=c") }, "disks/status=a%26b%3Dc"),
+		)
+
+		It("builds different list identifiers for different filters", func() {
+			Expect(getFilterID("disks", map[string]attr.Value{ "status": types.StringValue("Active") })).NotTo(
+				Equal(getFilterID("disks", map[string]attr.Value{ "status": types.StringValue("Error") })),
+			)
+		})
+	})
+}
@@ -12,12 +12,17 @@ package data_sources
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	warrenClient "gitlab.com/warrenio/library/go-client/warren"
-	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
 )
 
 func NewNetwork() datasource.DataSource {
@@ -44,8 +49,23 @@ func (d *Network) Configure(ctx context.Context, req datasource.ConfigureRequest
 	d.client = client
 }
 
+func (d *Network) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("is_default"),
+			path.MatchRoot("name"),
+			path.MatchRoot("name_regex"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("name"),
+			path.MatchRoot("name_regex"),
+		),
+	}
+}
+
 func (d *Network) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data warren.NetworkModel
+	var data NetworkModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -53,16 +73,115 @@ func (d *Network) Read(ctx context.Context, req datasource.ReadRequest, resp *da
 		return
 	}
 
-	err := warren.NetworkReadData(d.client, &data)
+	nameRegex, err := getLookupRegex(data.NameRegex)
 	if nil != err {
 		resp.Diagnostics.AddError("Network read error", err.Error())
 		return
 	}
 
+	networks, err := d.client.Network.ListNetworks()
+	if nil != err {
+		resp.Diagnostics.AddError("Network read error", apis.GetNetworkErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	var (
+		candidates []string
+		match      *warrenClient.Network
+	)
+
+	for index := range *networks {
+		network := &(*networks)[index]
+
+		if !isNetworkMatching(network, &data, nameRegex) {
+			continue
+		}
+
+		candidates = append(candidates, network.Uuid)
+		match = network
+	}
+
+	err = getLookupError(candidates, apis.ErrNetworkNotFound, "networks", d.getCriteriaString(&data))
+	if nil != err {
+		resp.Diagnostics.AddError("Network read error", err.Error())
+		return
+	}
+
+	setNetworkStateData(match, &data)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func (d *Network) getCriteriaString(data *NetworkModel) string {
+	var criteria []string
+
+	if !data.UUID.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("UUID %s", data.UUID.ValueString()))
+	}
+
+	if !data.Name.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("name %s", data.Name.ValueString()))
+	}
+
+	if !data.NameRegex.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("name regular expression %s", data.NameRegex.ValueString()))
+	}
+
+	if !data.IsDefault.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("default %s", data.IsDefault.String()))
+	}
+
+	return strings.Join(criteria, " and ")
+}
+
+// isNetworkMatching returns true if the network given matches all lookup
+// criteria given.
+//
+// PARAMETERS
+// network   *warrenClient.Network Network to check
+// data      *NetworkModel         Lookup criteria
+// nameRegex *regexp.Regexp        Name regular expression or nil
+func isNetworkMatching(network *warrenClient.Network, data *NetworkModel, nameRegex *regexp.Regexp) bool {
+	if !data.UUID.IsNull() && data.UUID.ValueString() != network.Uuid {
+		return false
+	}
+
+	if !data.Name.IsNull() && data.Name.ValueString() != network.Name {
+		return false
+	}
+
+	if nil != nameRegex && !nameRegex.MatchString(network.Name) {
+		return false
+	}
+
+	if !data.IsDefault.IsNull() && data.IsDefault.ValueBool() != network.IsDefault {
+		return false
+	}
+
+	return true
+}
+
+func setNetworkStateData(network *warrenClient.Network, data *NetworkModel) {
+	data.CreatedAt = types.StringValue(network.CreatedAt)
+	data.IsDefault = types.BoolValue(network.IsDefault)
+	data.Name = types.StringValue(network.Name)
+	data.SubnetIPv4 = types.StringValue(network.Subnet)
+	data.SubnetIPv6 = types.StringValue(network.SubnetIPv6)
+	data.Type = types.StringValue(network.Type)
+	data.UpdatedAt = types.StringValue(network.UpdatedAt)
+	data.UUID = types.StringValue(network.Uuid)
+	data.VLANID = types.Int64Value(int64(network.VlanId))
+
+	serverUUIDs := []attr.Value{}
+
+	for _, serverUUID := range network.VmUuids {
+		serverUUIDs = append(serverUUIDs, types.StringValue(serverUUID))
+	}
+
+	data.ServerUUIDs = types.ListValueMust(types.StringType, serverUUIDs)
+}
+
 func (d *Network) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_network"
 }
@@ -91,6 +210,10 @@ func (d *Network) Schema(ctx context.Context, req datasource.SchemaRequest, resp
 				Computed:            true,
 				Optional:            true,
 			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Network name regular expression to look up",
+				Optional:            true,
+			},
 			"server_uuids": schema.ListAttribute{
 				MarkdownDescription: "Network server UUIDs",
 				ElementType:         types.StringType,
@@ -11,11 +11,14 @@ package data_sources
 
 import (
 	"fmt"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/go-client/warren"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
 )
@@ -34,6 +37,20 @@ data "warren_network" "test" {
 	)
 }
 
+func generateNetworkLookupConfig(mockTestEnv mock.MockTestEnv, lookup string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+data "warren_network" "test" {
+	%s
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		lookup,
+	)
+}
+
 func NetworkTest(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
 	var mockTestEnv mock.MockTestEnv
 	t := GinkgoT()
@@ -69,6 +86,75 @@ func NetworkTest(providerFactories map[string]func() (tfprotov6.ProviderServer,
 			)
 		})
 
+		DescribeTable(
+			"is correctly looked up",
+			func(lookup string, expectedError string) {
+				step := resource.TestStep{
+					Config: generateNetworkLookupConfig(mockTestEnv, lookup),
+					Check:  resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.warren_network.test", "id", mock.TestNetworkUUID),
+					),
+				}
+
+				if "" != expectedError {
+					step.Check = nil
+					step.ExpectError = regexp.MustCompile(expectedError)
+				}
+
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps:                    []resource.TestStep{step},
+					},
+				)
+			},
+			Entry("by UUID", fmt.Sprintf("id = %q", mock.TestNetworkUUID), ""),
+			Entry("by name regular expression", `name_regex = "^te"`, ""),
+			Entry("by default state", "is_default = true", ""),
+			Entry("by name and default state", "name = \"test\"\n\tis_default = true", ""),
+			Entry("by name and unmatched default state", "name = \"test\"\n\tis_default = false", "No match found for name test and default false"),
+			Entry("by unknown name regular expression", `name_regex = "^prod"`, "No match found for name regular expression \\^prod"),
+		)
+
+		DescribeTable(
+			"matches all criteria given",
+			func(data NetworkModel, nameRegex string, expected bool) {
+				network := warren.Network{
+					IsDefault: true,
+					Name:      "test",
+					Uuid:      mock.TestNetworkUUID,
+				}
+
+				var lookupRegex *regexp.Regexp
+
+				if "" != nameRegex {
+					lookupRegex = regexp.MustCompile(nameRegex)
+				}
+
+				Expect(isNetworkMatching(&network, &data, lookupRegex)).To(Equal(expected))
+			},
+			Entry("without criteria", NetworkModel{}, "", true),
+			Entry("by UUID", NetworkModel{UUID: types.StringValue(mock.TestNetworkUUID)}, "", true),
+			Entry("by unknown UUID", NetworkModel{UUID: types.StringValue("unknown")}, "", false),
+			Entry("by name", NetworkModel{Name: types.StringValue("test")}, "", true),
+			Entry("by name regular expression", NetworkModel{}, "^t.st$", true),
+			Entry("by unmatched name regular expression", NetworkModel{}, "^prod", false),
+			Entry("by default state", NetworkModel{IsDefault: types.BoolValue(true)}, "", true),
+			Entry(
+				"by name and unmatched default state",
+				NetworkModel{IsDefault: types.BoolValue(false), Name: types.StringValue("test")},
+				"",
+				false,
+			),
+			Entry(
+				"by unknown name and default state",
+				NetworkModel{IsDefault: types.BoolValue(true), Name: types.StringValue("unknown")},
+				"",
+				false,
+			),
+		)
+
 		Expect(t.Failed()).To(BeFalse())
 	})
 }
@@ -0,0 +1,245 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewNetworks() datasource.DataSource {
+	return &Networks{}
+}
+
+func (d *Networks) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warren.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Networks configure error",
+			fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *Networks) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NetworksModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, err := getLookupRegex(data.NameRegex)
+	if nil != err {
+		resp.Diagnostics.AddError("Networks read error", err.Error())
+		return
+	}
+
+	networks, err := d.client.Network.ListNetworks()
+	if nil != err {
+		resp.Diagnostics.AddError("Networks read error", apis.GetNetworkErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	var matchingNetworks []*warren.Network
+
+	for index := range *networks {
+		network := &(*networks)[index]
+
+		if nil != nameRegex && !nameRegex.MatchString(network.Name) {
+			continue
+		}
+
+		if !data.Type.IsNull() && data.Type.ValueString() != network.Type {
+			continue
+		}
+
+		matchingNetworks = append(matchingNetworks, network)
+	}
+
+	data.Networks = []NetworksNetworkModel{}
+
+	for _, network := range matchingNetworks {
+		overlappingNetworkUUIDs := []attr.Value{}
+
+		// Overlaps are reported for all networks regardless of the filters given
+		for index := range *networks {
+			otherNetwork := &(*networks)[index]
+
+			if otherNetwork.Uuid != network.Uuid && isNetworkOverlapping(network, otherNetwork) {
+				overlappingNetworkUUIDs = append(overlappingNetworkUUIDs, types.StringValue(otherNetwork.Uuid))
+			}
+		}
+
+		vmUUIDs := []attr.Value{}
+
+		for _, vmUUID := range network.VmUuids {
+			vmUUIDs = append(vmUUIDs, types.StringValue(vmUUID))
+		}
+
+		data.Networks = append(
+			data.Networks,
+			NetworksNetworkModel{
+				CreatedAt:               types.StringValue(network.CreatedAt),
+				IsDefault:               types.BoolValue(network.IsDefault),
+				Name:                    types.StringValue(network.Name),
+				OverlappingNetworkUUIDs: types.ListValueMust(types.StringType, overlappingNetworkUUIDs),
+				ResourcesCount:          types.Int64Value(int64(network.ResourcesCount)),
+				SubnetIPv4:              types.StringValue(network.Subnet),
+				SubnetIPv6:              types.StringValue(network.SubnetIPv6),
+				Type:                    types.StringValue(network.Type),
+				UpdatedAt:               types.StringValue(network.UpdatedAt),
+				UUID:                    types.StringValue(network.Uuid),
+				VLANID:                  types.Int64Value(int64(network.VlanId)),
+				VMUUIDs:                 types.ListValueMust(types.StringType, vmUUIDs),
+			},
+		)
+	}
+
+	data.ID = types.StringValue(getFilterID("networks", map[string]attr.Value{
+		"name_regex": data.NameRegex,
+		"type":       data.Type,
+	}))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// isNetworkOverlapping returns true if the IPv4 or IPv6 subnets of the given
+// networks overlap.
+//
+// PARAMETERS
+// network      *warren.Network Network to check
+// otherNetwork *warren.Network Network to compare with
+func isNetworkOverlapping(network, otherNetwork *warren.Network) bool {
+	return isSubnetOverlapping(network.Subnet, otherNetwork.Subnet) || isSubnetOverlapping(network.SubnetIPv6, otherNetwork.SubnetIPv6)
+}
+
+// isSubnetOverlapping returns true if the given subnets in CIDR notation
+// overlap. Invalid or empty subnets never overlap.
+//
+// PARAMETERS
+// subnet      string Subnet to check
+// otherSubnet string Subnet to compare with
+func isSubnetOverlapping(subnet, otherSubnet string) bool {
+	_, ipNet, err := net.ParseCIDR(subnet)
+	if nil != err {
+		return false
+	}
+
+	_, otherIPNet, err := net.ParseCIDR(otherSubnet)
+	if nil != err {
+		return false
+	}
+
+	return ipNet.Contains(otherIPNet.IP) || otherIPNet.Contains(ipNet.IP)
+}
+
+func (d *Networks) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks"
+}
+
+func (d *Networks) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warren Platform networks",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Networks identifier built of the filters configured",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Network name regular expression to filter for",
+				Optional:            true,
+			},
+			"networks": schema.ListNestedAttribute{
+				MarkdownDescription: "Networks matching all filters given",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Network created at date and time",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Network UUID",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Network set as default",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Network name",
+							Computed:            true,
+						},
+						"overlapping_network_uuids": schema.ListAttribute{
+							MarkdownDescription: "UUIDs of all other networks with an overlapping IPv4 or IPv6 subnet, including networks not matching the filters given",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"resources_count": schema.Int64Attribute{
+							MarkdownDescription: "Network resources count",
+							Computed:            true,
+						},
+						"subnet_ipv4": schema.StringAttribute{
+							MarkdownDescription: "Network IPv4 subnet",
+							Computed:            true,
+						},
+						"subnet_ipv6": schema.StringAttribute{
+							MarkdownDescription: "Network IPv6 subnet",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Network type",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Network updated at date and time",
+							Computed:            true,
+						},
+						"vlan_id": schema.Int64Attribute{
+							MarkdownDescription: "Network VLAN ID",
+							Computed:            true,
+						},
+						"vm_uuids": schema.ListAttribute{
+							MarkdownDescription: "Network virtual machine UUIDs",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Network type to filter for",
+				Optional:            true,
+			},
+		},
+	}
+}
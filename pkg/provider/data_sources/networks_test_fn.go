@@ -0,0 +1,95 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
+)
+
+func generateNetworksConfig(mockTestEnv mock.MockTestEnv, filters string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+data "warren_networks" "test" {
+	%s
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		filters,
+	)
+}
+
+func NetworksTest(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
+	var mockTestEnv mock.MockTestEnv
+	t := GinkgoT()
+
+	var _ = BeforeEach(func() {
+		mockTestEnv = mock.NewMockTestEnv()
+
+		apis.SetClientForToken("dummy-token", mockTestEnv.Client)
+		mock.SetupNetworkEndpointOnMux(mockTestEnv.Mux, false)
+	})
+
+	var _ = AfterEach(func() {
+		mockTestEnv.Teardown()
+		apis.SetClientForToken("dummy-token", nil)
+	})
+
+	var _ = Describe("Networks", func() {
+		DescribeTable(
+			"are correctly filtered",
+			func(filters string, expectedCount string) {
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps: []resource.TestStep{
+							// Read testing
+							{
+								Config: generateNetworksConfig(mockTestEnv, filters),
+								Check:  resource.ComposeAggregateTestCheckFunc(
+									resource.TestCheckResourceAttr("data.warren_networks.test", "networks.#", expectedCount),
+								),
+							},
+						},
+					},
+				)
+			},
+			Entry("without filters", "", "1"),
+			Entry("by name regex", `name_regex = "^te"`, "1"),
+			Entry("by not matching name regex", `name_regex = "^prod-"`, "0"),
+			Entry("by type", `type = "private"`, "1"),
+			Entry("by unknown type", `type = "public"`, "0"),
+		)
+
+		DescribeTable(
+			"detect overlapping subnets",
+			func(subnet, otherSubnet string, expected bool) {
+				Expect(isSubnetOverlapping(subnet, otherSubnet)).To(Equal(expected))
+			},
+			Entry("with equal subnets", "10.42.0.0/24", "10.42.0.0/24", true),
+			Entry("with a contained subnet", "10.42.0.0/16", "10.42.1.0/24", true),
+			Entry("with a containing subnet", "10.42.1.0/24", "10.42.0.0/16", true),
+			Entry("with adjacent subnets", "10.42.0.0/24", "10.42.1.0/24", false),
+			Entry("with IPv6 subnets", "2001:db8::/48", "2001:db8:0:1::/64", true),
+			Entry("with an empty subnet", "", "10.42.0.0/24", false),
+		)
+
+		Expect(t.Failed()).To(BeFalse())
+	})
+}
@@ -12,8 +12,10 @@ package data_sources
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
-	"github.com/google/uuid"
+	"github.com/Masterminds/semver/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -49,10 +51,27 @@ func (d *OSBaseImage) Configure(ctx context.Context, req datasource.ConfigureReq
 
 func (d *OSBaseImage) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
 	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("display_name"),
+			path.MatchRoot("os_name"),
+			path.MatchRoot("os_name_regex"),
+		),
 		datasourcevalidator.Conflicting(
 			path.MatchRoot("display_name"),
 			path.MatchRoot("os_name"),
 		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("os_name"),
+			path.MatchRoot("os_name_regex"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("os_version"),
+			path.MatchRoot("most_recent"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("os_version"),
+			path.MatchRoot("os_version_constraint"),
+		),
 	}
 }
 
@@ -65,95 +84,190 @@ func (d *OSBaseImage) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
+	osNameRegex, err := getLookupRegex(data.OSNameRegex)
+	if nil != err {
+		resp.Diagnostics.AddError("OS base image read error", err.Error())
+		return
+	}
+
+	var versionConstraint *semver.Constraints
+
+	if !data.OSVersionConstraint.IsNull() {
+		versionConstraint, err = semver.NewConstraint(data.OSVersionConstraint.ValueString())
+		if nil != err {
+			resp.Diagnostics.AddError("OS base image read error", fmt.Sprintf("Invalid OS version constraint: %s", err.Error()))
+			return
+		}
+	}
+
+	isVersionSelectionRequested := data.MostRecent.ValueBool() || nil != versionConstraint
+
 	images, err := d.client.VirtualMachine.ListBaseImages()
 	if nil != err {
 		resp.Diagnostics.AddError("OS base image read error", apis.GetImageErrorFromHttpCallError(err).Error())
 		return
 	}
 
-	var isFound bool
+	var (
+		candidates []string
+		match      *warren.BaseImage
+	)
 
-	for _, image := range *images {
-		if !data.DisplayName.IsNull() && data.DisplayName.Equal(types.StringValue(image.DisplayName)) {
-			isFound = true
-		}
+	for index := range *images {
+		image := &(*images)[index]
 
-		if !data.OSName.IsNull() && data.OSName.Equal(types.StringValue(image.OsName)) {
-			isFound = true
+		if !isOSBaseImageMatching(image, &data, osNameRegex) {
+			continue
 		}
 
-		if isFound && !data.OSVersion.IsNull() {
-			var isOSVersionFound bool
-			osVersion := data.OSVersion.ValueString()
+		candidates = append(candidates, image.OsName)
+		match = image
+	}
 
-			for _, imageVersion := range image.Versions {
-				if osVersion == imageVersion.OsVersion {
-					isOSVersionFound = true
-					break
-				}
-			}
+	err = getLookupError(candidates, apis.ErrImageNotFound, "OS base images", d.getCriteriaString(&data))
+	if nil != err {
+		resp.Diagnostics.AddError("OS base image read error", err.Error())
+		return
+	}
+
+	data.DisplayName = types.StringValue(match.DisplayName)
+	data.IsAppCatalog = types.BoolValue(match.IsAppCatalog)
+	data.IsDefault = types.BoolValue(match.IsDefault)
+	data.OSName = types.StringValue(match.OsName)
+	data.SelectedVersion = data.OSVersion
+
+	if isVersionSelectionRequested {
+		selectedVersion := getMostRecentOSBaseImageVersion(match.Versions, versionConstraint)
+
+		if "" == selectedVersion {
+			var additionalVersionConstraint string
 
-			if !isOSVersionFound {
-				isFound = false
+			if nil != versionConstraint {
+				additionalVersionConstraint = fmt.Sprintf(" (Constraint %s)", data.OSVersionConstraint.ValueString())
 			}
-		}
 
-		if !isFound {
-			continue
+			resp.Diagnostics.AddError(
+				"OS base image read error",
+				fmt.Sprintf("No published version found for OS name: %s%s", match.OsName, additionalVersionConstraint),
+			)
+
+			return
 		}
 
-		data.DisplayName = types.StringValue(image.DisplayName)
-		data.IsAppCatalog = types.BoolValue(image.IsAppCatalog)
-		data.IsDefault = types.BoolValue(image.IsDefault)
-		data.OSName = types.StringValue(image.OsName)
+		data.SelectedVersion = types.StringValue(selectedVersion)
+	}
 
-		// ID is used for testing only
-		if data.ID.IsNull() {
-			data.ID = types.StringValue(uuid.NewString())
-		}
+	// ID is derived from the lookup key to stay stable across reads
+	data.ID = types.StringValue(match.OsName)
+
+	if !data.SelectedVersion.IsNull() {
+		data.ID = types.StringValue(fmt.Sprintf("%s/%s", match.OsName, data.SelectedVersion.ValueString()))
+	}
+
+	for _, imageVersion := range match.Versions {
+		data.Versions = append(
+			data.Versions,
+			OSBaseImageVersionModel{
+				DisplayName: types.StringValue(imageVersion.DisplayName),
+				OSVersion:   types.StringValue(imageVersion.OsVersion),
+				Published:   types.BoolValue(imageVersion.Published),
+			},
+		)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OSBaseImage) getCriteriaString(data *OSBaseImageModel) string {
+	var criteria []string
+
+	if !data.DisplayName.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("display name %s", data.DisplayName.ValueString()))
+	}
+
+	if !data.OSName.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("OS name %s", data.OSName.ValueString()))
+	}
+
+	if !data.OSNameRegex.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("OS name regular expression %s", data.OSNameRegex.ValueString()))
+	}
 
+	if !data.OSVersion.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("OS version %s", data.OSVersion.ValueString()))
+	}
+
+	return strings.Join(criteria, " and ")
+}
+
+// isOSBaseImageMatching returns true if the OS base image given matches all
+// lookup criteria given.
+//
+// PARAMETERS
+// image       *warren.BaseImage OS base image to check
+// data        *OSBaseImageModel Lookup criteria
+// osNameRegex *regexp.Regexp    OS name regular expression or nil
+func isOSBaseImageMatching(image *warren.BaseImage, data *OSBaseImageModel, osNameRegex *regexp.Regexp) bool {
+	if !data.DisplayName.IsNull() && data.DisplayName.ValueString() != image.DisplayName {
+		return false
+	}
+
+	if !data.OSName.IsNull() && data.OSName.ValueString() != image.OsName {
+		return false
+	}
+
+	if nil != osNameRegex && !osNameRegex.MatchString(image.OsName) {
+		return false
+	}
+
+	if !data.OSVersion.IsNull() {
 		for _, imageVersion := range image.Versions {
-			data.Versions = append(
-				data.Versions,
-				OSBaseImageVersionModel{
-					DisplayName: types.StringValue(imageVersion.DisplayName),
-					OSVersion:   types.StringValue(imageVersion.OsVersion),
-					Published:   types.BoolValue(imageVersion.Published),
-				},
-			)
+			if data.OSVersion.ValueString() == imageVersion.OsVersion {
+				return true
+			}
 		}
 
-		if isFound {
-			break
-		}
+		return false
 	}
 
-	if !isFound {
-		var additionalVersionConstraint string
+	return true
+}
 
-		if !data.OSVersion.IsNull() {
-			additionalVersionConstraint = fmt.Sprintf(" (Version %s)", data.OSVersion.ValueString())
+// getMostRecentOSBaseImageVersion returns the most recent published OS version
+// satisfying the given constraint. OS versions not parsable as a semantic
+// version are ignored. An empty string is returned if no OS version matches.
+//
+// PARAMETERS
+// versions   []warren.BaseImageVersion OS base image versions to select from
+// constraint *semver.Constraints       OS version constraint or nil
+func getMostRecentOSBaseImageVersion(versions []warren.BaseImageVersion, constraint *semver.Constraints) string {
+	var (
+		mostRecentVersion   *semver.Version
+		mostRecentOSVersion string
+	)
+
+	for _, imageVersion := range versions {
+		if !imageVersion.Published {
+			continue
 		}
 
-		if !data.DisplayName.IsNull() {
-			resp.Diagnostics.AddError(
-				"OS base image read error",
-				fmt.Sprintf("No match found for display name: %s%s", data.DisplayName.ValueString(), additionalVersionConstraint),
-			)
+		version, err := semver.NewVersion(imageVersion.OsVersion)
+		if nil != err {
+			continue
 		}
 
-		if !data.OSName.IsNull() {
-			resp.Diagnostics.AddError(
-				"OS base image read error",
-				fmt.Sprintf("No match found for OS name: %s%s", data.OSName.ValueString(), additionalVersionConstraint),
-			)
+		if nil != constraint && !constraint.Check(version) {
+			continue
 		}
 
-		return
+		if nil == mostRecentVersion || version.GreaterThan(mostRecentVersion) {
+			mostRecentVersion = version
+			mostRecentOSVersion = imageVersion.OsVersion
+		}
 	}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return mostRecentOSVersion
 }
 
 func (d *OSBaseImage) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -170,10 +284,8 @@ func (d *OSBaseImage) Schema(ctx context.Context, req datasource.SchemaRequest,
 				Computed:            true,
 				Optional:            true,
 			},
-			// ID is used for testing only
 			"id": schema.StringAttribute{
-				MarkdownDescription: "OS base image ID",
-				DeprecationMessage:  "Try to Remove id Attribute Requirement - https://github.com/hashicorp/terraform-plugin-testing/issues/84",
+				MarkdownDescription: "OS base image ID (`os_name/selected_version` or `os_name` if no OS version is selected)",
 				Computed:            true,
 			},
 			"is_app_catalog": schema.BoolAttribute{
@@ -184,15 +296,31 @@ func (d *OSBaseImage) Schema(ctx context.Context, req datasource.SchemaRequest,
 				MarkdownDescription: "OS base image set as default",
 				Computed:            true,
 			},
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "Select the most recent published OS version",
+				Optional:            true,
+			},
 			"os_name": schema.StringAttribute{
 				MarkdownDescription: "OS name",
 				Computed:            true,
 				Optional:            true,
 			},
+			"os_name_regex": schema.StringAttribute{
+				MarkdownDescription: "OS name regular expression to look up",
+				Optional:            true,
+			},
 			"os_version": schema.StringAttribute{
 				MarkdownDescription: "OS version",
 				Optional:            true,
 			},
+			"os_version_constraint": schema.StringAttribute{
+				MarkdownDescription: "OS version constraint (e.g. `>= 22.04`) the most recent published OS version is selected for",
+				Optional:            true,
+			},
+			"selected_version": schema.StringAttribute{
+				MarkdownDescription: "OS version selected by `os_version`, `most_recent` or `os_version_constraint`",
+				Computed:            true,
+			},
 			"versions": schema.ListNestedAttribute{
 				MarkdownDescription: "OS versions",
 				Computed:            true,
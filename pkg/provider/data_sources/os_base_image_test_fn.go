@@ -11,11 +11,14 @@ package data_sources
 
 import (
 	"fmt"
+	"regexp"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/go-client/warren"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
 )
@@ -33,6 +36,35 @@ data "warren_os_base_image" "test" {
 	)
 }
 
+func generateOSBaseImageVersionSelectionConfig(mockTestEnv mock.MockTestEnv, selection string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+data "warren_os_base_image" "test" {
+	os_name = "ubuntu"
+	%s
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		selection,
+	)
+}
+
+func generateOSBaseImageLookupConfig(mockTestEnv mock.MockTestEnv, lookup string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+data "warren_os_base_image" "test" {
+	%s
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		lookup,
+	)
+}
+
 func OSBaseImageTest(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
 	var mockTestEnv mock.MockTestEnv
 	t := GinkgoT()
@@ -68,6 +100,108 @@ func OSBaseImageTest(providerFactories map[string]func() (tfprotov6.ProviderServ
 			)
 		})
 
+		DescribeTable(
+			"is correctly looked up",
+			func(lookup string, expectedOSName string, expectedError string) {
+				step := resource.TestStep{
+					Config: generateOSBaseImageLookupConfig(mockTestEnv, lookup),
+					Check:  resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.warren_os_base_image.test", "os_name", expectedOSName),
+					),
+				}
+
+				if "" != expectedError {
+					step.Check = nil
+					step.ExpectError = regexp.MustCompile(expectedError)
+				}
+
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps:                    []resource.TestStep{step},
+					},
+				)
+			},
+			Entry("by display name", `display_name = "WordPress"`, "wordpress", ""),
+			Entry("by OS name regular expression", `os_name_regex = "^ubu"`, "ubuntu", ""),
+			Entry("by OS name regular expression and OS version", "os_name_regex = \"^ubu\"\n\tos_version = \"20.04\"", "ubuntu", ""),
+			Entry("by OS name and unmatched OS version", "os_name = \"ubuntu\"\n\tos_version = \"6.2\"", "", "No match found for OS name ubuntu and OS version 6.2"),
+			Entry("by ambiguous OS name regular expression", `os_name_regex = "."`, "", "(?s)Multiple OS base images found.*ubuntu,.*wordpress"),
+		)
+
+		DescribeTable(
+			"selects the most recent published version",
+			func(selection string, expectedVersion string) {
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps: []resource.TestStep{
+							// Read testing
+							{
+								Config: generateOSBaseImageVersionSelectionConfig(mockTestEnv, selection),
+								Check:  resource.ComposeAggregateTestCheckFunc(
+									resource.TestCheckResourceAttr("data.warren_os_base_image.test", "selected_version", expectedVersion),
+									resource.TestCheckResourceAttr("data.warren_os_base_image.test", "versions.#", "2"),
+								),
+							},
+						},
+					},
+				)
+			},
+			Entry("with an exact version", `os_version = "20.04"`, "20.04"),
+			Entry("with most recent", "most_recent = true", "21.04"),
+			Entry("with a version constraint", `os_version_constraint = "< 21.04"`, "20.04"),
+			Entry("with most recent and a version constraint", "most_recent = true\n\tos_version_constraint = \">= 20.04\"", "21.04"),
+		)
+
+		It("fails if no version satisfies the constraint", func() {
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Read testing
+						{
+							Config:      generateOSBaseImageVersionSelectionConfig(mockTestEnv, `os_version_constraint = ">= 22.04"`),
+							ExpectError: regexp.MustCompile("No published version found for OS name: ubuntu"),
+						},
+					},
+				},
+			)
+		})
+
+		DescribeTable(
+			"sorts versions version-aware",
+			func(constraint string, expectedVersion string) {
+				versions := []warren.BaseImageVersion{
+					{OsVersion: "9.10", Published: true},
+					{OsVersion: "22.10", Published: true},
+					{OsVersion: "22.04", Published: true},
+					{OsVersion: "23.04", Published: false},
+					{OsVersion: "18.04", Published: true},
+					{OsVersion: "bookworm", Published: true},
+				}
+
+				var versionConstraint *semver.Constraints
+
+				if "" != constraint {
+					var err error
+
+					versionConstraint, err = semver.NewConstraint(constraint)
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				Expect(getMostRecentOSBaseImageVersion(versions, versionConstraint)).To(Equal(expectedVersion))
+			},
+			Entry("without a constraint", "", "22.10"),
+			Entry("with an upper bound", "< 22.10", "22.04"),
+			Entry("with a lower bound", ">= 10", "22.10"),
+			Entry("with a tilde range", "~9", "9.10"),
+			Entry("with an unpublished version only", ">= 23", ""),
+		)
+
 		Expect(t.Failed()).To(BeFalse())
 	})
 }
@@ -0,0 +1,182 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewOSBaseImages() datasource.DataSource {
+	return &OSBaseImages{}
+}
+
+func (d *OSBaseImages) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warren.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"OS base images configure error",
+			fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OSBaseImages) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OSBaseImagesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	osNameRegex, err := getLookupRegex(data.OSNameRegex)
+	if nil != err {
+		resp.Diagnostics.AddError("OS base images read error", err.Error())
+		return
+	}
+
+	images, err := d.client.VirtualMachine.ListBaseImages()
+	if nil != err {
+		resp.Diagnostics.AddError("OS base images read error", apis.GetImageErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	data.Images = []OSBaseImagesImageModel{}
+
+	for _, image := range *images {
+		if !data.IsAppCatalog.IsNull() && data.IsAppCatalog.ValueBool() != image.IsAppCatalog {
+			continue
+		}
+
+		if !data.IsDefault.IsNull() && data.IsDefault.ValueBool() != image.IsDefault {
+			continue
+		}
+
+		if nil != osNameRegex && !osNameRegex.MatchString(image.OsName) {
+			continue
+		}
+
+		for _, imageVersion := range image.Versions {
+			if !data.Published.IsNull() && data.Published.ValueBool() != imageVersion.Published {
+				continue
+			}
+
+			data.Images = append(
+				data.Images,
+				OSBaseImagesImageModel{
+					DisplayName:        types.StringValue(image.DisplayName),
+					IsAppCatalog:       types.BoolValue(image.IsAppCatalog),
+					IsDefault:          types.BoolValue(image.IsDefault),
+					OSName:             types.StringValue(image.OsName),
+					OSVersion:          types.StringValue(imageVersion.OsVersion),
+					Published:          types.BoolValue(imageVersion.Published),
+					VersionDisplayName: types.StringValue(imageVersion.DisplayName),
+				},
+			)
+		}
+	}
+
+	data.ID = types.StringValue(getFilterID("os_base_images", map[string]attr.Value{
+		"is_app_catalog": data.IsAppCatalog,
+		"is_default":     data.IsDefault,
+		"os_name_regex":  data.OSNameRegex,
+		"published":      data.Published,
+	}))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OSBaseImages) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_os_base_images"
+}
+
+func (d *OSBaseImages) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warren Platform virtual machine OS base images flattened per OS version",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "OS base images identifier built of the filters configured",
+				Computed:            true,
+			},
+			"images": schema.ListNestedAttribute{
+				MarkdownDescription: "OS base image versions matching all filters given",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "OS base image display name",
+							Computed:            true,
+						},
+						"is_app_catalog": schema.BoolAttribute{
+							MarkdownDescription: "OS base image from app catalog",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "OS base image set as default",
+							Computed:            true,
+						},
+						"os_name": schema.StringAttribute{
+							MarkdownDescription: "OS name",
+							Computed:            true,
+						},
+						"os_version": schema.StringAttribute{
+							MarkdownDescription: "OS version",
+							Computed:            true,
+						},
+						"published": schema.BoolAttribute{
+							MarkdownDescription: "OS version published state",
+							Computed:            true,
+						},
+						"version_display_name": schema.StringAttribute{
+							MarkdownDescription: "OS version display name",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"is_app_catalog": schema.BoolAttribute{
+				MarkdownDescription: "OS base image app catalog state to filter for",
+				Optional:            true,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "OS base image default state to filter for",
+				Optional:            true,
+			},
+			"os_name_regex": schema.StringAttribute{
+				MarkdownDescription: "OS name regular expression to filter for",
+				Optional:            true,
+			},
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "OS version published state to filter for",
+				Optional:            true,
+			},
+		},
+	}
+}
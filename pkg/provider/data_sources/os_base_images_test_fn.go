@@ -0,0 +1,106 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
+)
+
+func generateOSBaseImagesConfig(mockTestEnv mock.MockTestEnv, filters string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+data "warren_os_base_images" "test" {
+	%s
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		filters,
+	)
+}
+
+func OSBaseImagesTest(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
+	var mockTestEnv mock.MockTestEnv
+	t := GinkgoT()
+
+	var _ = BeforeEach(func() {
+		mockTestEnv = mock.NewMockTestEnv()
+
+		apis.SetClientForToken("dummy-token", mockTestEnv.Client)
+		mock.SetupVMImagesEndpointOnMux(mockTestEnv.Mux)
+	})
+
+	var _ = AfterEach(func() {
+		mockTestEnv.Teardown()
+		apis.SetClientForToken("dummy-token", nil)
+	})
+
+	var _ = Describe("OSBaseImages", func() {
+		DescribeTable(
+			"are correctly filtered",
+			func(filters string, expectedCount string) {
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps: []resource.TestStep{
+							// Read testing
+							{
+								Config: generateOSBaseImagesConfig(mockTestEnv, filters),
+								Check:  resource.ComposeAggregateTestCheckFunc(
+									resource.TestCheckResourceAttr("data.warren_os_base_images.test", "images.#", expectedCount),
+								),
+							},
+						},
+					},
+				)
+			},
+			Entry("without filters", "", "4"),
+			Entry("by app catalog state", "is_app_catalog = true", "2"),
+			Entry("by default state", "is_default = true", "2"),
+			Entry("by published state", "published = false", "1"),
+			Entry("by OS name regular expression", `os_name_regex = "^ubu"`, "2"),
+			Entry("by all filters", "is_app_catalog = true\n\tpublished = true", "1"),
+		)
+
+		It("are flattened per OS version", func() {
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Read testing
+						{
+							Config: generateOSBaseImagesConfig(mockTestEnv, "published = false"),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("data.warren_os_base_images.test", "id", "os_base_images/published=false"),
+								resource.TestCheckResourceAttr("data.warren_os_base_images.test", "images.0.display_name", "WordPress"),
+								resource.TestCheckResourceAttr("data.warren_os_base_images.test", "images.0.is_app_catalog", "true"),
+								resource.TestCheckResourceAttr("data.warren_os_base_images.test", "images.0.os_name", "wordpress"),
+								resource.TestCheckResourceAttr("data.warren_os_base_images.test", "images.0.os_version", "6.3"),
+								resource.TestCheckResourceAttr("data.warren_os_base_images.test", "images.0.version_display_name", "6.3 (Beta)"),
+							),
+						},
+					},
+				},
+			)
+		})
+
+		Expect(t.Failed()).To(BeFalse())
+	})
+}
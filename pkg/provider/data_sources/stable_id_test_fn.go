@@ -0,0 +1,177 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
+)
+
+func StableIDTest(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
+	var mockTestEnv mock.MockTestEnv
+	t := GinkgoT()
+
+	var _ = BeforeEach(func() {
+		mockTestEnv = mock.NewMockTestEnv()
+
+		apis.SetClientForToken("dummy-token", mockTestEnv.Client)
+		mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, false)
+		mock.SetupIPAddressesEndpointOnMux(mockTestEnv.Mux, false)
+		mock.SetupLocationEndpointOnMux(mockTestEnv.Mux)
+		mock.SetupNetworkEndpointOnMux(mockTestEnv.Mux, false)
+		mock.SetupVMEndpointOnMux(mockTestEnv.Mux, false)
+		mock.SetupVMImagesEndpointOnMux(mockTestEnv.Mux)
+	})
+
+	var _ = AfterEach(func() {
+		mockTestEnv.Teardown()
+		apis.SetClientForToken("dummy-token", nil)
+	})
+
+	var _ = Describe("Data source IDs", func() {
+		DescribeTable(
+			"are stable across reads",
+			func(address string, generateConfig func(mock.MockTestEnv) string, expectedID string) {
+				var firstID string
+
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps: []resource.TestStep{
+							// Read testing
+							{
+								Config: generateConfig(mockTestEnv),
+								Check:  resource.ComposeAggregateTestCheckFunc(
+									resource.TestCheckResourceAttr(address, "id", expectedID),
+									resource.TestCheckResourceAttrWith(address, "id", func(value string) error {
+										firstID = value
+										return nil
+									}),
+								),
+							},
+							// Refresh testing
+							{
+								Config: generateConfig(mockTestEnv),
+								Check:  resource.ComposeAggregateTestCheckFunc(
+									resource.TestCheckResourceAttrPtr(address, "id", &firstID),
+								),
+							},
+						},
+					},
+				)
+			},
+			Entry(
+				"for disks",
+				"data.warren_disk.test",
+				func(mockTestEnv mock.MockTestEnv) string { return generateDiskConfig(mockTestEnv, mock.TestDiskUUID) },
+				mock.TestDiskUUID,
+			),
+			Entry(
+				"for floating IPs",
+				"data.warren_floating_ip.test",
+				func(mockTestEnv mock.MockTestEnv) string { return generateFloatingIPConfig(mockTestEnv, "address", mock.TestFloatingIP) },
+				mock.TestFloatingIPUUID,
+			),
+			Entry(
+				"for locations",
+				"data.warren_location.test",
+				func(mockTestEnv mock.MockTestEnv) string { return generateLocationConfig(mockTestEnv, "cyc01") },
+				"cyc01",
+			),
+			Entry(
+				"for networks",
+				"data.warren_network.test",
+				func(mockTestEnv mock.MockTestEnv) string { return generateNetworkConfig(mockTestEnv, "test") },
+				mock.TestNetworkUUID,
+			),
+			Entry(
+				"for OS base images",
+				"data.warren_os_base_image.test",
+				generateOSBaseImageConfig,
+				"ubuntu",
+			),
+			Entry(
+				"for OS base images with a selected version",
+				"data.warren_os_base_image.test",
+				func(mockTestEnv mock.MockTestEnv) string {
+					return generateOSBaseImageVersionSelectionConfig(mockTestEnv, "most_recent = true")
+				},
+				"ubuntu/21.04",
+			),
+			Entry(
+				"for virtual machines",
+				"data.warren_virtual_machine.test",
+				func(mockTestEnv mock.MockTestEnv) string {
+					return generateVirtualMachineConfig(mockTestEnv, "id", mock.TestServerUUID)
+				},
+				mock.TestServerUUID,
+			),
+			Entry(
+				"for lists of disks",
+				"data.warren_disks.test",
+				func(mockTestEnv mock.MockTestEnv) string { return generateDisksConfig(mockTestEnv, "") },
+				"disks",
+			),
+			Entry(
+				"for filtered lists of disks",
+				"data.warren_disks.test",
+				func(mockTestEnv mock.MockTestEnv) string {
+					return generateDisksConfig(mockTestEnv, "attached = true\n\tmin_size_in_gb = 10")
+				},
+				"disks/attached=true&min_size_in_gb=10",
+			),
+			Entry(
+				"for lists of floating IPs",
+				"data.warren_floating_ips.test",
+				func(mockTestEnv mock.MockTestEnv) string { return generateFloatingIPsConfig(mockTestEnv, "") },
+				"floating_ips",
+			),
+			Entry(
+				"for lists of locations",
+				"data.warren_locations.test",
+				func(mockTestEnv mock.MockTestEnv) string { return generateLocationsConfig(mockTestEnv, "") },
+				"locations",
+			),
+			Entry(
+				"for lists of networks",
+				"data.warren_networks.test",
+				func(mockTestEnv mock.MockTestEnv) string { return generateNetworksConfig(mockTestEnv, "") },
+				"networks",
+			),
+			Entry(
+				"for lists of OS base images",
+				"data.warren_os_base_images.test",
+				func(mockTestEnv mock.MockTestEnv) string { return generateOSBaseImagesConfig(mockTestEnv, "") },
+				"os_base_images",
+			),
+			Entry(
+				"for lists of virtual machines",
+				"data.warren_virtual_machines.test",
+				func(mockTestEnv mock.MockTestEnv) string { return generateVirtualMachinesConfig(mockTestEnv, "") },
+				"virtual_machines",
+			),
+			Entry(
+				"for filtered lists of virtual machines",
+				"data.warren_virtual_machines.test",
+				func(mockTestEnv mock.MockTestEnv) string {
+					return generateVirtualMachinesConfig(mockTestEnv, "name_prefix = \"test\"")
+				},
+				"virtual_machines/name_prefix=test",
+			),
+		)
+
+		Expect(t.Failed()).To(BeFalse())
+	})
+}
@@ -14,18 +14,140 @@ import (
 	"gitlab.com/warrenio/library/go-client/warren"
 )
 
+// Disk defines the data source implementation.
+type Disk struct {
+	client *warren.Client
+}
+
+// DiskModel describes the data source model for a disk.
+type DiskModel struct {
+	Attached        types.Bool   `tfsdk:"attached"`
+	BillingAccount  types.Int64  `tfsdk:"billing_account"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	ServerUUID      types.String `tfsdk:"server_uuid"`
+	SizeInGB        types.Int64  `tfsdk:"size_in_gb"`
+	Snapshots       types.List   `tfsdk:"snapshots"`
+	SourceImageType types.String `tfsdk:"source_image_type"`
+	SourceImageUUID types.String `tfsdk:"source_image_uuid"`
+	Status          types.String `tfsdk:"status"`
+	StatusComment   types.String `tfsdk:"status_comment"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	UserID          types.Int64  `tfsdk:"user_id"`
+	UUID            types.String `tfsdk:"id"`
+}
+
+// Disks defines the data source implementation.
+type Disks struct {
+	client *warren.Client
+}
+
+// DisksModel describes the data source model for a list of disks.
+type DisksModel struct {
+	Attached        types.Bool   `tfsdk:"attached"`
+	Disks           []DiskModel  `tfsdk:"disks"`
+	ID              types.String `tfsdk:"id"`
+	MaxSizeInGB     types.Int64  `tfsdk:"max_size_in_gb"`
+	MinSizeInGB     types.Int64  `tfsdk:"min_size_in_gb"`
+	SourceImageType types.String `tfsdk:"source_image_type"`
+	Status          types.String `tfsdk:"status"`
+}
+
+// FloatingIP defines the data source implementation.
+type FloatingIP struct {
+	client *warren.Client
+}
+
+// FloatingIPModel describes the data source model for a floating IP.
+type FloatingIPModel struct {
+	Address                types.String `tfsdk:"address"`
+	AssignedTo             types.String `tfsdk:"assigned_to"`
+	AssignedToPrivateIP    types.String `tfsdk:"assigned_to_private_ip"`
+	AssignedToResourceType types.String `tfsdk:"assigned_to_resource_type"`
+	BillingAccount         types.Int64  `tfsdk:"billing_account"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	ID                     types.String `tfsdk:"id"`
+	IsIPv6                 types.Bool   `tfsdk:"is_ipv6"`
+	Name                   types.String `tfsdk:"name"`
+	NameRegex              types.String `tfsdk:"name_regex"`
+	NetworkUUID            types.String `tfsdk:"network_uuid"`
+	Type                   types.String `tfsdk:"type"`
+	UpdatedAt              types.String `tfsdk:"updated_at"`
+	UserID                 types.Int64  `tfsdk:"user_id"`
+}
+
+// FloatingIPs defines the data source implementation.
+type FloatingIPs struct {
+	client *warren.Client
+}
+
+// FloatingIPsModel describes the data source model for a list of floating IPs.
+type FloatingIPsModel struct {
+	Assigned               types.Bool                   `tfsdk:"assigned"`
+	AssignedToResourceType types.String                 `tfsdk:"assigned_to_resource_type"`
+	FloatingIPs            []FloatingIPsFloatingIPModel `tfsdk:"floating_ips"`
+	ID                     types.String                 `tfsdk:"id"`
+	IsIPv6                 types.Bool                   `tfsdk:"is_ipv6"`
+	Name                   types.String                 `tfsdk:"name"`
+}
+
+// FloatingIPsFloatingIPModel describes the data source model for floating IPs
+// of a list of floating IPs.
+type FloatingIPsFloatingIPModel struct {
+	Address                types.String `tfsdk:"address"`
+	AssignedTo             types.String `tfsdk:"assigned_to"`
+	AssignedToPrivateIP    types.String `tfsdk:"assigned_to_private_ip"`
+	AssignedToResourceType types.String `tfsdk:"assigned_to_resource_type"`
+	BillingAccount         types.Int64  `tfsdk:"billing_account"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	ID                     types.String `tfsdk:"id"`
+	IsIPv6                 types.Bool   `tfsdk:"is_ipv6"`
+	Name                   types.String `tfsdk:"name"`
+	NetworkUUID            types.String `tfsdk:"network_uuid"`
+	Type                   types.String `tfsdk:"type"`
+	UpdatedAt              types.String `tfsdk:"updated_at"`
+	UserID                 types.Int64  `tfsdk:"user_id"`
+}
+
 // Location defines the data source implementation.
 type Location struct {
 	client *warren.Client
 }
 
-// LocationModel describes the data source model for an OS base image.
+// LocationModel describes the data source model for a location.
 type LocationModel struct {
+	CountryCode      types.String `tfsdk:"country_code"`
+	Description      types.String `tfsdk:"description"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	DisplayNameRegex types.String `tfsdk:"display_name_regex"`
+	IsDefault        types.Bool   `tfsdk:"is_default"`
+	IsPreferred      types.Bool   `tfsdk:"is_preferred"`
+	OrderNr          types.Int64  `tfsdk:"order_nr"`
+	Slug             types.String `tfsdk:"id"`
+}
+
+// Locations defines the data source implementation.
+type Locations struct {
+	client *warren.Client
+}
+
+// LocationsModel describes the data source model for a list of locations.
+type LocationsModel struct {
+	CountryCode types.String             `tfsdk:"country_code"`
+	ID          types.String             `tfsdk:"id"`
+	Locations   []LocationsLocationModel `tfsdk:"locations"`
+}
+
+// LocationsLocationModel describes the data source model for locations of a
+// list of locations.
+type LocationsLocationModel struct {
 	CountryCode types.String `tfsdk:"country_code"`
 	Description types.String `tfsdk:"description"`
 	DisplayName types.String `tfsdk:"display_name"`
 	IsDefault   types.Bool   `tfsdk:"is_default"`
 	IsPreferred types.Bool   `tfsdk:"is_preferred"`
+	OrderNr     types.Int64  `tfsdk:"order_nr"`
 	Slug        types.String `tfsdk:"id"`
 }
 
@@ -34,6 +156,50 @@ type Network struct {
 	client *warren.Client
 }
 
+// NetworkModel describes the data source model for a network.
+type NetworkModel struct {
+	CreatedAt   types.String `tfsdk:"created_at"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	Name        types.String `tfsdk:"name"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	ServerUUIDs types.List   `tfsdk:"server_uuids"`
+	SubnetIPv4  types.String `tfsdk:"subnet_ipv4"`
+	SubnetIPv6  types.String `tfsdk:"subnet_ipv6"`
+	Type        types.String `tfsdk:"type"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	UUID        types.String `tfsdk:"id"`
+	VLANID      types.Int64  `tfsdk:"vlan_id"`
+}
+
+// Networks defines the data source implementation.
+type Networks struct {
+	client *warren.Client
+}
+
+// NetworksModel describes the data source model for a list of networks.
+type NetworksModel struct {
+	ID        types.String           `tfsdk:"id"`
+	NameRegex types.String           `tfsdk:"name_regex"`
+	Networks  []NetworksNetworkModel `tfsdk:"networks"`
+	Type      types.String           `tfsdk:"type"`
+}
+
+// NetworksNetworkModel describes the data source model for networks of a list of networks.
+type NetworksNetworkModel struct {
+	CreatedAt               types.String `tfsdk:"created_at"`
+	IsDefault               types.Bool   `tfsdk:"is_default"`
+	Name                    types.String `tfsdk:"name"`
+	OverlappingNetworkUUIDs types.List   `tfsdk:"overlapping_network_uuids"`
+	ResourcesCount          types.Int64  `tfsdk:"resources_count"`
+	SubnetIPv4              types.String `tfsdk:"subnet_ipv4"`
+	SubnetIPv6              types.String `tfsdk:"subnet_ipv6"`
+	Type                    types.String `tfsdk:"type"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
+	UUID                    types.String `tfsdk:"id"`
+	VLANID                  types.Int64  `tfsdk:"vlan_id"`
+	VMUUIDs                 types.List   `tfsdk:"vm_uuids"`
+}
+
 // OSBaseImage defines the data source implementation.
 type OSBaseImage struct {
 	client *warren.Client
@@ -41,13 +207,17 @@ type OSBaseImage struct {
 
 // OSBaseImageModel describes the data source model for an OS base image.
 type OSBaseImageModel struct {
-	DisplayName  types.String              `tfsdk:"display_name"`
-	ID           types.String              `tfsdk:"id"`
-	IsAppCatalog types.Bool                `tfsdk:"is_app_catalog"`
-	IsDefault    types.Bool                `tfsdk:"is_default"`
-	OSName       types.String              `tfsdk:"os_name"`
-	OSVersion    types.String              `tfsdk:"os_version"`
-	Versions     []OSBaseImageVersionModel `tfsdk:"versions"`
+	DisplayName         types.String              `tfsdk:"display_name"`
+	ID                  types.String              `tfsdk:"id"`
+	IsAppCatalog        types.Bool                `tfsdk:"is_app_catalog"`
+	IsDefault           types.Bool                `tfsdk:"is_default"`
+	MostRecent          types.Bool                `tfsdk:"most_recent"`
+	OSName              types.String              `tfsdk:"os_name"`
+	OSNameRegex         types.String              `tfsdk:"os_name_regex"`
+	OSVersion           types.String              `tfsdk:"os_version"`
+	OSVersionConstraint types.String              `tfsdk:"os_version_constraint"`
+	SelectedVersion     types.String              `tfsdk:"selected_version"`
+	Versions            []OSBaseImageVersionModel `tfsdk:"versions"`
 }
 
 // OSBaseImageModel describes the data source model for versions of an OS base image.
@@ -56,3 +226,105 @@ type OSBaseImageVersionModel struct {
 	OSVersion   types.String `tfsdk:"os_version"`
 	Published   types.Bool   `tfsdk:"published"`
 }
+
+// OSBaseImages defines the data source implementation.
+type OSBaseImages struct {
+	client *warren.Client
+}
+
+// OSBaseImagesModel describes the data source model for a list of OS base
+// image versions.
+type OSBaseImagesModel struct {
+	ID           types.String             `tfsdk:"id"`
+	Images       []OSBaseImagesImageModel `tfsdk:"images"`
+	IsAppCatalog types.Bool               `tfsdk:"is_app_catalog"`
+	IsDefault    types.Bool               `tfsdk:"is_default"`
+	OSNameRegex  types.String             `tfsdk:"os_name_regex"`
+	Published    types.Bool               `tfsdk:"published"`
+}
+
+// OSBaseImagesImageModel describes the data source model for a single
+// version of an OS base image.
+type OSBaseImagesImageModel struct {
+	DisplayName        types.String `tfsdk:"display_name"`
+	IsAppCatalog       types.Bool   `tfsdk:"is_app_catalog"`
+	IsDefault          types.Bool   `tfsdk:"is_default"`
+	OSName             types.String `tfsdk:"os_name"`
+	OSVersion          types.String `tfsdk:"os_version"`
+	Published          types.Bool   `tfsdk:"published"`
+	VersionDisplayName types.String `tfsdk:"version_display_name"`
+}
+
+// VirtualMachine defines the data source implementation.
+type VirtualMachine struct {
+	client *warren.Client
+}
+
+// VirtualMachineModel describes the data source model for a virtual machine.
+type VirtualMachineModel struct {
+	Backup         types.Bool   `tfsdk:"backup"`
+	BillingAccount types.Int64  `tfsdk:"billing_account"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Description    types.String `tfsdk:"description"`
+	DiskSizeInGB   types.Int64  `tfsdk:"disk_size_in_gb"`
+	Hostname       types.String `tfsdk:"hostname"`
+	MAC            types.String `tfsdk:"mac"`
+	Memory         types.Int64  `tfsdk:"memory"`
+	Name           types.String `tfsdk:"name"`
+	NameRegex      types.String `tfsdk:"name_regex"`
+	NetworkUUID    types.String `tfsdk:"network_uuid"`
+	OSName         types.String `tfsdk:"os_name"`
+	OSVersion      types.String `tfsdk:"os_version"`
+	PrivateIPv4    types.String `tfsdk:"private_ipv4"`
+	PublicIPv6     types.String `tfsdk:"public_ipv6"`
+	Status         types.String `tfsdk:"status"`
+	Storage        types.List   `tfsdk:"storage"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	UserID         types.Int64  `tfsdk:"user_id"`
+	Username       types.String `tfsdk:"username"`
+	UUID           types.String `tfsdk:"id"`
+	VCPU           types.Int64  `tfsdk:"vcpu"`
+}
+
+// VirtualMachinesVirtualMachineModel describes the data source model for
+// virtual machines of a list of virtual machines.
+type VirtualMachinesVirtualMachineModel struct {
+	Backup         types.Bool   `tfsdk:"backup"`
+	BillingAccount types.Int64  `tfsdk:"billing_account"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Description    types.String `tfsdk:"description"`
+	DiskSizeInGB   types.Int64  `tfsdk:"disk_size_in_gb"`
+	Hostname       types.String `tfsdk:"hostname"`
+	MAC            types.String `tfsdk:"mac"`
+	Memory         types.Int64  `tfsdk:"memory"`
+	Name           types.String `tfsdk:"name"`
+	NetworkUUID    types.String `tfsdk:"network_uuid"`
+	OSName         types.String `tfsdk:"os_name"`
+	OSVersion      types.String `tfsdk:"os_version"`
+	PrivateIPv4    types.String `tfsdk:"private_ipv4"`
+	PublicIPv6     types.String `tfsdk:"public_ipv6"`
+	Status         types.String `tfsdk:"status"`
+	Storage        types.List   `tfsdk:"storage"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	UserID         types.Int64  `tfsdk:"user_id"`
+	Username       types.String `tfsdk:"username"`
+	UUID           types.String `tfsdk:"id"`
+	VCPU           types.Int64  `tfsdk:"vcpu"`
+}
+
+// VirtualMachines defines the data source implementation.
+type VirtualMachines struct {
+	client *warren.Client
+}
+
+// VirtualMachinesModel describes the data source model for a list of virtual machines.
+type VirtualMachinesModel struct {
+	BillingAccount  types.Int64                          `tfsdk:"billing_account"`
+	ID              types.String                         `tfsdk:"id"`
+	NamePrefix      types.String                         `tfsdk:"name_prefix"`
+	NameRegex       types.String                         `tfsdk:"name_regex"`
+	NetworkUUID     types.String                         `tfsdk:"network_uuid"`
+	OSName          types.String                         `tfsdk:"os_name"`
+	Status          types.String                         `tfsdk:"status"`
+	VirtualMachines []VirtualMachinesVirtualMachineModel `tfsdk:"virtual_machines"`
+}
@@ -0,0 +1,390 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	warrenClient "gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewVirtualMachine() datasource.DataSource {
+	return &VirtualMachine{}
+}
+
+func (d *VirtualMachine) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warrenClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Virtual machine configure error",
+			fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VirtualMachine) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("hostname"),
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("name_regex"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("name"),
+			path.MatchRoot("name_regex"),
+		),
+	}
+}
+
+func (d *VirtualMachine) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtualMachineModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, err := getLookupRegex(data.NameRegex)
+	if nil != err {
+		resp.Diagnostics.AddError("Virtual machine read error", err.Error())
+		return
+	}
+
+	var servers []warrenClient.VirtualMachine
+
+	if !data.UUID.IsNull() {
+		server, err := d.client.VirtualMachine.GetByUuid(data.UUID.ValueString())
+		if nil != err {
+			resp.Diagnostics.AddError("Virtual machine read error", apis.GetServerErrorFromHttpCallError(err).Error())
+			return
+		}
+
+		servers = append(servers, *server)
+	} else {
+		serverList, err := d.client.VirtualMachine.ListVms()
+		if nil != err {
+			resp.Diagnostics.AddError("Virtual machine read error", apis.GetServerErrorFromHttpCallError(err).Error())
+			return
+		}
+
+		servers = *serverList
+	}
+
+	var (
+		candidates []string
+		match      *warrenClient.VirtualMachine
+	)
+
+	for index := range servers {
+		if !isVirtualMachineMatching(&servers[index], &data, nameRegex) {
+			continue
+		}
+
+		candidates = append(candidates, servers[index].Uuid)
+		match = &servers[index]
+	}
+
+	err = getLookupError(candidates, apis.ErrServerNotFound, "virtual machines", d.getCriteriaString(&data))
+	if nil != err {
+		resp.Diagnostics.AddError("Virtual machine read error", err.Error())
+		return
+	}
+
+	network, err := apis.GetNetworkFromServerUUID(d.client, match.Uuid)
+	if nil != err && !errors.Is(err, apis.ErrNetworkNotFound) {
+		resp.Diagnostics.AddError("Virtual machine read error", err.Error())
+		return
+	}
+
+	setVirtualMachineStateData(match, network, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *VirtualMachine) getCriteriaString(data *VirtualMachineModel) string {
+	var criteria []string
+
+	if !data.UUID.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("UUID %s", data.UUID.ValueString()))
+	}
+
+	if !data.Name.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("name %s", data.Name.ValueString()))
+	}
+
+	if !data.NameRegex.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("name regular expression %s", data.NameRegex.ValueString()))
+	}
+
+	if !data.Hostname.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("hostname %s", data.Hostname.ValueString()))
+	}
+
+	return strings.Join(criteria, " and ")
+}
+
+// isVirtualMachineMatching returns true if the virtual machine given matches
+// all lookup criteria given.
+//
+// PARAMETERS
+// server    *warrenClient.VirtualMachine Virtual machine to check
+// data      *VirtualMachineModel         Lookup criteria
+// nameRegex *regexp.Regexp               Name regular expression or nil
+func isVirtualMachineMatching(server *warrenClient.VirtualMachine, data *VirtualMachineModel, nameRegex *regexp.Regexp) bool {
+	if !data.UUID.IsNull() && data.UUID.ValueString() != server.Uuid {
+		return false
+	}
+
+	if !data.Name.IsNull() && data.Name.ValueString() != server.Name {
+		return false
+	}
+
+	if nil != nameRegex && !nameRegex.MatchString(server.Name) {
+		return false
+	}
+
+	if !data.Hostname.IsNull() && data.Hostname.ValueString() != server.Hostname {
+		return false
+	}
+
+	return true
+}
+
+func setVirtualMachineStateData(server *warrenClient.VirtualMachine, network *warrenClient.Network, data *VirtualMachineModel) {
+	data.Backup = types.BoolValue(server.Backup)
+	data.BillingAccount = types.Int64Value(int64(server.BillingAccount))
+	data.CreatedAt = types.StringValue(server.CreatedAt)
+	data.Description = types.StringValue(server.Description)
+	data.DiskSizeInGB = types.Int64Null()
+	data.Hostname = types.StringValue(server.Hostname)
+	data.MAC = types.StringValue(server.Mac)
+	data.Memory = types.Int64Value(int64(server.Memory))
+	data.Name = types.StringValue(server.Name)
+	data.NetworkUUID = types.StringNull()
+	data.OSName = types.StringValue(server.OsName)
+	data.OSVersion = types.StringValue(server.OsVersion)
+	data.PrivateIPv4 = types.StringValue(server.PrivateIPv4)
+	data.PublicIPv6 = types.StringValue(server.PublicIPv6)
+	data.Status = types.StringValue(server.Status)
+	data.Storage = warren.VirtualMachineStorageListValue(server.Storage)
+	data.UpdatedAt = types.StringValue(server.UpdatedAt)
+	data.UserID = types.Int64Value(int64(server.UserId))
+	data.Username = types.StringValue(server.Username)
+	data.UUID = types.StringValue(server.Uuid)
+	data.VCPU = types.Int64Value(int64(server.VCpu))
+
+	for _, serverStorage := range server.Storage {
+		if serverStorage.Primary {
+			data.DiskSizeInGB = types.Int64Value(int64(serverStorage.Size))
+		}
+	}
+
+	if nil != network {
+		data.NetworkUUID = types.StringValue(network.Uuid)
+	}
+}
+
+func (d *VirtualMachine) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_machine"
+}
+
+func (d *VirtualMachine) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warren Platform virtual machine",
+
+		Attributes: getVirtualMachineSchemaAttributes(true),
+	}
+}
+
+// getVirtualMachineSchemaAttributes returns the schema attributes of a
+// virtual machine.
+//
+// PARAMETERS
+// isLookup bool True if the hostname, UUID and name are used for lookups
+func getVirtualMachineSchemaAttributes(isLookup bool) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"backup": schema.BoolAttribute{
+			MarkdownDescription: "Virtual machine backup value",
+			Computed:            true,
+		},
+		"billing_account": schema.Int64Attribute{
+			MarkdownDescription: "Virtual machine billing account ID",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine created at date and time",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine description",
+			Computed:            true,
+		},
+		"disk_size_in_gb": schema.Int64Attribute{
+			MarkdownDescription: "Virtual machine boot disk size in GB",
+			Computed:            true,
+		},
+		"hostname": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine hostname",
+			Computed:            true,
+			Optional:            isLookup,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine UUID",
+			Computed:            true,
+			Optional:            isLookup,
+		},
+		"mac": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine MAC",
+			Computed:            true,
+		},
+		"memory": schema.Int64Attribute{
+			MarkdownDescription: "Virtual machine memory value in MB",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine name",
+			Computed:            true,
+			Optional:            isLookup,
+		},
+		"network_uuid": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine network UUID attached",
+			Computed:            true,
+		},
+		"os_name": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine OS image name",
+			Computed:            true,
+		},
+		"os_version": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine OS image version",
+			Computed:            true,
+		},
+		"private_ipv4": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine private IPv4",
+			Computed:            true,
+		},
+		"public_ipv6": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine public IPv6",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine status",
+			Computed:            true,
+		},
+		"storage": schema.ListNestedAttribute{
+			MarkdownDescription: "Virtual machine storages",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"created_at": schema.StringAttribute{
+						MarkdownDescription: "Virtual machine storage created at date and time",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Virtual machine storage name",
+						Computed:            true,
+					},
+					"primary": schema.BoolAttribute{
+						MarkdownDescription: "Virtual machine storage is primary if set",
+						Computed:            true,
+					},
+					"replica": schema.ListNestedAttribute{
+						MarkdownDescription: "Virtual machine storage replicas",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"created_at": schema.StringAttribute{
+									MarkdownDescription: "Virtual machine storage replica created at date and time",
+									Computed:            true,
+								},
+								"master_uuid": schema.StringAttribute{
+									MarkdownDescription: "Virtual machine storage replica master UUID",
+									Computed:            true,
+								},
+								"size_in_gb": schema.Int64Attribute{
+									MarkdownDescription: "Virtual machine storage replica size",
+									Computed:            true,
+								},
+								"type": schema.StringAttribute{
+									MarkdownDescription: "Virtual machine storage replica type",
+									Computed:            true,
+								},
+								"uuid": schema.StringAttribute{
+									MarkdownDescription: "Virtual machine storage replica UUID",
+									Computed:            true,
+								},
+							},
+						},
+					},
+					"size_in_gb": schema.Int64Attribute{
+						MarkdownDescription: "Virtual machine storage size",
+						Computed:            true,
+					},
+					"user_id": schema.Int64Attribute{
+						MarkdownDescription: "Virtual machine storage owner's user ID",
+						Computed:            true,
+					},
+					"uuid": schema.StringAttribute{
+						MarkdownDescription: "Virtual machine storage UUID",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine updated at date and time",
+			Computed:            true,
+		},
+		"user_id": schema.Int64Attribute{
+			MarkdownDescription: "Virtual machine owner's user ID",
+			Computed:            true,
+		},
+		"username": schema.StringAttribute{
+			MarkdownDescription: "Virtual machine user name for SSH access",
+			Computed:            true,
+		},
+		"vcpu": schema.Int64Attribute{
+			MarkdownDescription: "Virtual machine VCPU value",
+			Computed:            true,
+		},
+	}
+
+	if isLookup {
+		attributes["name_regex"] = schema.StringAttribute{
+			MarkdownDescription: "Virtual machine name regular expression to look up",
+			Optional:            true,
+		}
+	}
+
+	return attributes
+}
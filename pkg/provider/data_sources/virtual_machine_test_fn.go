@@ -0,0 +1,105 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
+)
+
+func generateVirtualMachineConfig(mockTestEnv mock.MockTestEnv, attribute string, value string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+data "warren_virtual_machine" "test" {
+	%s = %q
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		attribute,
+		value,
+	)
+}
+
+func VirtualMachineTest(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
+	var mockTestEnv mock.MockTestEnv
+	t := GinkgoT()
+
+	testServerName := fmt.Sprintf(mock.TestServerNameTemplate, mock.TestServerUUID)
+
+	var _ = BeforeEach(func() {
+		mockTestEnv = mock.NewMockTestEnv()
+
+		apis.SetClientForToken("dummy-token", mockTestEnv.Client)
+		mock.SetupNetworkEndpointOnMux(mockTestEnv.Mux, false)
+		mock.SetupVMEndpointOnMux(mockTestEnv.Mux, false)
+	})
+
+	var _ = AfterEach(func() {
+		mockTestEnv.Teardown()
+		apis.SetClientForToken("dummy-token", nil)
+	})
+
+	var _ = Describe("Virtual machine", func() {
+		DescribeTable(
+			"is correctly read",
+			func(attribute, value string) {
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps: []resource.TestStep{
+							// Read testing
+							{
+								Config: generateVirtualMachineConfig(mockTestEnv, attribute, value),
+								Check:  resource.ComposeAggregateTestCheckFunc(
+									resource.TestCheckResourceAttr("data.warren_virtual_machine.test", "id", mock.TestServerUUID),
+									resource.TestCheckResourceAttr("data.warren_virtual_machine.test", "network_uuid", mock.TestNetworkUUID),
+									resource.TestCheckResourceAttr("data.warren_virtual_machine.test", "private_ipv4", "10.42.0.1"),
+									resource.TestCheckResourceAttr("data.warren_virtual_machine.test", "storage.0.uuid", mock.TestDiskUUID),
+								),
+							},
+						},
+					},
+				)
+			},
+			Entry("by UUID", "id", mock.TestServerUUID),
+			Entry("by name", "name", testServerName),
+			Entry("by hostname", "hostname", testServerName),
+			Entry("by name regular expression", "name_regex", "^machine-"),
+		)
+
+		It("fails for unknown names", func() {
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Read testing
+						{
+							Config:      generateVirtualMachineConfig(mockTestEnv, "name", "unknown"),
+							ExpectError: regexp.MustCompile("No match found for name unknown"),
+						},
+					},
+				},
+			)
+		})
+
+		Expect(t.Failed()).To(BeFalse())
+	})
+}
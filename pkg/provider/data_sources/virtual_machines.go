@@ -0,0 +1,208 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package data_sources contains all Terraform data sources supported
+package data_sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewVirtualMachines() datasource.DataSource {
+	return &VirtualMachines{}
+}
+
+func (d *VirtualMachines) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warren.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Virtual machines configure error",
+			fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VirtualMachines) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtualMachinesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, err := getLookupRegex(data.NameRegex)
+	if nil != err {
+		resp.Diagnostics.AddError("Virtual machines read error", err.Error())
+		return
+	}
+
+	servers, err := d.client.VirtualMachine.ListVms()
+	if nil != err {
+		resp.Diagnostics.AddError("Virtual machines read error", apis.GetServerErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	networks, err := d.client.Network.ListNetworks()
+	if nil != err {
+		resp.Diagnostics.AddError("Virtual machines read error", apis.GetNetworkErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	data.VirtualMachines = []VirtualMachinesVirtualMachineModel{}
+
+	for index := range *servers {
+		server := &(*servers)[index]
+
+		if !data.NamePrefix.IsNull() && !strings.HasPrefix(server.Name, data.NamePrefix.ValueString()) {
+			continue
+		}
+
+		if nil != nameRegex && !nameRegex.MatchString(server.Name) {
+			continue
+		}
+
+		if !data.Status.IsNull() && data.Status.ValueString() != server.Status {
+			continue
+		}
+
+		if !data.OSName.IsNull() && data.OSName.ValueString() != server.OsName {
+			continue
+		}
+
+		if !data.BillingAccount.IsNull() && data.BillingAccount.ValueInt64() != int64(server.BillingAccount) {
+			continue
+		}
+
+		network, err := apis.GetNetworkFromServer(networks, server)
+		if nil != err && !errors.Is(err, apis.ErrNetworkNotFound) {
+			resp.Diagnostics.AddError("Virtual machines read error", err.Error())
+			return
+		}
+
+		if !data.NetworkUUID.IsNull() && (nil == network || data.NetworkUUID.ValueString() != network.Uuid) {
+			continue
+		}
+
+		serverData := VirtualMachineModel{}
+		setVirtualMachineStateData(server, network, &serverData)
+
+		data.VirtualMachines = append(data.VirtualMachines, getVirtualMachinesVirtualMachineModel(&serverData))
+	}
+
+	data.ID = types.StringValue(getFilterID("virtual_machines", map[string]attr.Value{
+		"billing_account": data.BillingAccount,
+		"name_prefix":     data.NamePrefix,
+		"name_regex":      data.NameRegex,
+		"network_uuid":    data.NetworkUUID,
+		"os_name":         data.OSName,
+		"status":          data.Status,
+	}))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getVirtualMachinesVirtualMachineModel returns the virtual machines list
+// model of the given virtual machine data.
+//
+// PARAMETERS
+// data *VirtualMachineModel Virtual machine data
+func getVirtualMachinesVirtualMachineModel(data *VirtualMachineModel) VirtualMachinesVirtualMachineModel {
+	return VirtualMachinesVirtualMachineModel{
+		Backup:         data.Backup,
+		BillingAccount: data.BillingAccount,
+		CreatedAt:      data.CreatedAt,
+		Description:    data.Description,
+		DiskSizeInGB:   data.DiskSizeInGB,
+		Hostname:       data.Hostname,
+		MAC:            data.MAC,
+		Memory:         data.Memory,
+		Name:           data.Name,
+		NetworkUUID:    data.NetworkUUID,
+		OSName:         data.OSName,
+		OSVersion:      data.OSVersion,
+		PrivateIPv4:    data.PrivateIPv4,
+		PublicIPv6:     data.PublicIPv6,
+		Status:         data.Status,
+		Storage:        data.Storage,
+		UpdatedAt:      data.UpdatedAt,
+		UserID:         data.UserID,
+		Username:       data.Username,
+		UUID:           data.UUID,
+		VCPU:           data.VCPU,
+	}
+}
+
+func (d *VirtualMachines) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_machines"
+}
+
+func (d *VirtualMachines) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warren Platform virtual machines",
+
+		Attributes: map[string]schema.Attribute{
+			"billing_account": schema.Int64Attribute{
+				MarkdownDescription: "Virtual machine billing account ID to filter for",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Virtual machines identifier built of the filters configured",
+				Computed:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Virtual machine name prefix to filter for",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Virtual machine name regular expression to filter for",
+				Optional:            true,
+			},
+			"network_uuid": schema.StringAttribute{
+				MarkdownDescription: "Virtual machine network UUID to filter for",
+				Optional:            true,
+			},
+			"os_name": schema.StringAttribute{
+				MarkdownDescription: "Virtual machine OS image name to filter for",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Virtual machine status to filter for",
+				Optional:            true,
+			},
+			"virtual_machines": schema.ListNestedAttribute{
+				MarkdownDescription: "Virtual machines matching all filters given",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: getVirtualMachineSchemaAttributes(false),
+				},
+			},
+		},
+	}
+}
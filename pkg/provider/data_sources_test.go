@@ -15,7 +15,18 @@ import (
 )
 
 var _ = Describe("Resources", func() {
+	data_sources.DiskTest(testProviderV6Factories)
+	data_sources.DisksTest(testProviderV6Factories)
+	data_sources.FloatingIPTest(testProviderV6Factories)
+	data_sources.FloatingIPsTest(testProviderV6Factories)
 	data_sources.LocationTest(testProviderV6Factories)
+	data_sources.LocationsTest(testProviderV6Factories)
+	data_sources.LookupTest()
 	data_sources.NetworkTest(testProviderV6Factories)
+	data_sources.NetworksTest(testProviderV6Factories)
 	data_sources.OSBaseImageTest(testProviderV6Factories)
+	data_sources.OSBaseImagesTest(testProviderV6Factories)
+	data_sources.StableIDTest(testProviderV6Factories)
+	data_sources.VirtualMachineTest(testProviderV6Factories)
+	data_sources.VirtualMachinesTest(testProviderV6Factories)
 })
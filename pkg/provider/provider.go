@@ -84,6 +84,8 @@ func (p *WarrenProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *WarrenProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewDisk,
+		resources.NewDiskAttachment,
+		resources.NewDiskSnapshot,
 		resources.NewFloatingIP,
 		resources.NewNetwork,
 		resources.NewVirtualMachine,
@@ -92,9 +94,18 @@ func (p *WarrenProvider) Resources(ctx context.Context) []func() resource.Resour
 
 func (p *WarrenProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		data_sources.NewDisk,
+		data_sources.NewDisks,
+		data_sources.NewFloatingIP,
+		data_sources.NewFloatingIPs,
 		data_sources.NewLocation,
+		data_sources.NewLocations,
 		data_sources.NewNetwork,
+		data_sources.NewNetworks,
 		data_sources.NewOSBaseImage,
+		data_sources.NewOSBaseImages,
+		data_sources.NewVirtualMachine,
+		data_sources.NewVirtualMachines,
 	}
 }
 
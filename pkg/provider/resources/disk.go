@@ -13,17 +13,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"gitlab.com/warrenio/library/go-client/warren"
+	warrenTypes "gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
 )
 
@@ -47,6 +51,12 @@ func (r *Disk) Configure(ctx context.Context, req resource.ConfigureRequest, res
 	r.client = client
 }
 
+func (r *Disk) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		diskSourceImageValidator{},
+	}
+}
+
 func (r *Disk) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data DiskModel
 
@@ -75,7 +85,21 @@ func (r *Disk) create(ctx context.Context, req resource.CreateRequest, data *Dis
 
 	createReq := &warren.CreateDiskRequest{ SizeGb: warren.New(int(data.SizeInGB.ValueInt64())) }
 
-	if !data.SourceImageUUID.IsNull() {
+	timeout := diskCreateTimeout
+
+	if !data.CloneFromDiskUUID.IsNull() {
+		tflog.Info(ctx, fmt.Sprintf("Disk will be cloned from disk UUID: %s", data.CloneFromDiskUUID.ValueString()))
+
+		createReq.SourceImage = warren.New(data.CloneFromDiskUUID.ValueString())
+		createReq.SourceImageType = warren.New(warren.DISK)
+		timeout = diskCopyTimeout
+	} else if !data.ExternalImageURL.IsNull() {
+		tflog.Info(ctx, fmt.Sprintf("Disk will be imported from external image URL: %s", data.ExternalImageURL.ValueString()))
+
+		createReq.SourceImage = warren.New(data.ExternalImageURL.ValueString())
+		createReq.SourceImageType = warren.New(warren.EXTERNAL)
+		timeout = diskCopyTimeout
+	} else if !data.SourceImageUUID.IsNull() {
 		createReq.SourceImage = warren.New(data.SourceImageUUID.ValueString())
 
 		if !data.SourceImageType.IsNull() {
@@ -90,6 +114,11 @@ func (r *Disk) create(ctx context.Context, req resource.CreateRequest, data *Dis
 
 	resultData.DiskUUID = disk.Uuid
 
+	disk, err = apis.WaitForVolumeUsable(ctx, r.client, disk.Uuid, timeout)
+	if nil != err {
+		return err
+	}
+
 	if !data.ServerUUID.IsNull() {
 		_, err := r.client.VirtualMachine.AttachDisk(data.ServerUUID.ValueString(), disk.Uuid)
 		if nil != err {
@@ -97,9 +126,7 @@ func (r *Disk) create(ctx context.Context, req resource.CreateRequest, data *Dis
 		}
 	}
 
-	r.setStateData(ctx, disk, data)
-
-	return nil
+	return r.setStateData(ctx, disk, data)
 }
 
 // createOnErrorCleanup cleans up a failed disk creation request
@@ -140,20 +167,90 @@ func (r *Disk) Delete(ctx context.Context, req resource.DeleteRequest, resp *res
 		return
 	}
 
-	err = r.client.BlockStorage.DeleteDiskById(diskUUID)
+	server, err := apis.GetServerFromVolumeUUID(r.client, diskUUID)
+	if nil != server {
+		tflog.Trace(ctx, fmt.Sprintf("Disk %s will be detached from server UUID before deletion: %s", diskUUID, server.Uuid))
+
+		err = detachDisk(ctx, r.client, server.Uuid, diskUUID, data.ForceDetach.ValueBool())
+		if nil != err {
+			resp.Diagnostics.AddError("Disk delete error", err.Error())
+			return
+		}
+	} else if !errors.Is(err, apis.ErrServerNotFound) {
+		resp.Diagnostics.AddError("Disk delete error", err.Error())
+		return
+	}
+
+	err = apis.RetryOnServerLocked(ctx, diskDeleteTimeout, func() error {
+		return apis.GetVolumeErrorFromHttpCallError(r.client.BlockStorage.DeleteDiskById(diskUUID))
+	})
+
 	if nil != err {
-		resp.Diagnostics.AddError("Disk delete error", apis.GetVolumeErrorFromHttpCallError(err).Error())
+		resp.Diagnostics.AddError("Disk delete error", err.Error())
 	}
 }
 
+// detachDisk detaches the disk from the server given and waits until the
+// server no longer lists it. Locked servers are retried until the detach
+// timeout is exceeded or stopped first if forced.
+//
+// PARAMETERS
+// ctx         context.Context Execution context
+// client      *warren.Client  Warren client
+// serverUUID  string          Server ID the disk is attached to
+// diskUUID    string          Disk ID to detach
+// forceDetach bool            True to stop the server if it is locked
+func detachDisk(ctx context.Context, client *warren.Client, serverUUID string, diskUUID string, forceDetach bool) error {
+	isServerStopped := false
+
+	err := apis.RetryOnServerLocked(ctx, diskDetachTimeout, func() error {
+		err := apis.GetServerErrorFromHttpCallError(client.VirtualMachine.DetachDisk(serverUUID, diskUUID))
+
+		if errors.Is(err, apis.ErrServerIsLocked) && forceDetach && !isServerStopped {
+			tflog.Info(ctx, fmt.Sprintf("Server will be stopped to detach disk %s: %s", diskUUID, serverUUID))
+
+			_, stopErr := client.VirtualMachine.StopVm(serverUUID, false)
+			if nil != stopErr {
+				return apis.GetServerErrorFromHttpCallError(stopErr)
+			}
+
+			isServerStopped = true
+		}
+
+		return err
+	})
+
+	if nil == err {
+		err = apis.WaitForVolumeDetached(ctx, client, serverUUID, diskUUID, diskDetachTimeout)
+	}
+
+	if isServerStopped {
+		tflog.Info(ctx, fmt.Sprintf("Server will be started again after detaching disk %s: %s", diskUUID, serverUUID))
+
+		_, startErr := client.VirtualMachine.StartVm(serverUUID)
+		if nil == err && nil != startErr {
+			err = apis.GetServerErrorFromHttpCallError(startErr)
+		}
+	}
+
+	return err
+}
+
 func (r *Disk) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	disk, err := r.client.BlockStorage.GetDiskById(req.ID)
+	importID, err := parseImportID(ctx, r.client, req.ID)
 	if nil != err {
-		resp.Diagnostics.AddError("Disk import error", apis.GetVolumeErrorFromHttpCallError(err).Error())
+		resp.Diagnostics.AddError("Disk import error", err.Error())
+		return
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-	if resp.Diagnostics.HasError() {
+	if "" != importID.Name {
+		resp.Diagnostics.AddError("Disk import error", "Disks can only be imported by UUID as they do not have a name")
+		return
+	}
+
+	disk, err := importID.Client.BlockStorage.GetDiskById(importID.UUID)
+	if nil != err {
+		resp.Diagnostics.AddError("Disk import error", apis.GetVolumeErrorFromHttpCallError(err).Error())
 		return
 	}
 
@@ -168,6 +265,64 @@ func (r *Disk) Metadata(ctx context.Context, req resource.MetadataRequest, resp
 	resp.TypeName = req.ProviderTypeName + "_disk"
 }
 
+func (r *Disk) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Nothing to do for resource instance destruction
+		return
+	}
+
+	var (
+		planData  DiskModel
+		stateData DiskModel
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if nil != r.client {
+			err := getDiskSourceImageError(r.client, planData.SourceImageType, planData.SourceImageUUID)
+			if nil != err {
+				resp.Diagnostics.AddAttributeError(path.Root("source_image_uuid"), "Disk source image error", err.Error())
+			}
+
+			err = getDiskSourceImageError(r.client, types.StringValue(string(warren.DISK)), planData.CloneFromDiskUUID)
+			if nil != err {
+				resp.Diagnostics.AddAttributeError(path.Root("clone_from_disk_uuid"), "Disk source image error", err.Error())
+			}
+		}
+
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planData.SizeInGB.IsUnknown() || stateData.SizeInGB.IsNull() {
+		return
+	}
+
+	if planData.SizeInGB.ValueInt64() < stateData.SizeInGB.ValueInt64() {
+		tflog.Debug(
+			ctx,
+			fmt.Sprintf(
+				"Disk %s can not be shrunk from %d GB to %d GB and will be replaced",
+				stateData.UUID.ValueString(),
+				stateData.SizeInGB.ValueInt64(),
+				planData.SizeInGB.ValueInt64(),
+			),
+		)
+
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("size_in_gb"))
+	}
+}
+
 func (r *Disk) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data DiskModel
 
@@ -191,7 +346,11 @@ func (r *Disk) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
 		return
 	}
 
-	r.setStateData(ctx, disk, &data)
+	err = r.setStateData(ctx, disk, &data)
+	if nil != err {
+		resp.Diagnostics.AddError("Disk read error", err.Error())
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -209,28 +368,20 @@ func (r *Disk) setStateData(ctx context.Context, disk *warren.Disk, data *DiskMo
 	data.UserID = types.Int64Value(int64(disk.UserId))
 	data.UUID = types.StringValue(disk.Uuid)
 
-	snapshots := []attr.Value{}
-
-	for _, diskSnapshot := range disk.Snapshots {
-		snapshots = append(
-			snapshots,
-			types.ObjectValueMust(
-				DiskSnapshotType,
-				map[string]attr.Value{
-					"created_at": types.StringValue(diskSnapshot.CreatedAt),
-					"disk_uuid":  types.StringValue(diskSnapshot.DiskUuid),
-					"size_in_gb": types.Int64Value(int64(diskSnapshot.SizeGb)),
-					"uuid":       types.StringValue(diskSnapshot.Uuid),
-				},
-			),
-		)
-	}
+	data.Snapshots = warrenTypes.DiskSnapshotListValue(disk.Snapshots)
 
-	data.Snapshots = types.ListValueMust(types.ObjectType{ AttrTypes: DiskSnapshotType }, snapshots)
+	// Attachments made elsewhere, e.g. with "warren_disk_attachment", are
+	// ignored if "server_uuid" is not set.
+	if !data.ServerUUID.IsNull() {
+		server, err := apis.GetServerFromVolumeUUID(r.client, disk.Uuid)
 
-	server, _ := apis.GetServerFromVolumeUUID(r.client, disk.Uuid)
-	if nil != server {
-		data.ServerUUID = types.StringValue(server.Uuid)
+		if nil != server {
+			data.ServerUUID = types.StringValue(server.Uuid)
+		} else if errors.Is(err, apis.ErrServerNotFound) {
+			data.ServerUUID = types.StringNull()
+		} else {
+			return err
+		}
 	}
 
 	return nil
@@ -239,7 +390,7 @@ func (r *Disk) setStateData(ctx context.Context, disk *warren.Disk, data *DiskMo
 func (r *Disk) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Warren Platform disk",
+		MarkdownDescription: "Warren Platform disk. Removing `server_uuid` from the configuration does not detach the disk anymore and shows no difference in the plan. To detach such a disk, import its attachment with `terraform import warren_disk_attachment.<name> <disk-uuid>` into a `warren_disk_attachment` resource and destroy it. Attachments continuing to be managed independently of the disk are moved the same way while keeping the `warren_disk_attachment` resource.",
 
 		Attributes: map[string]schema.Attribute{
 			"billing_account": schema.Int64Attribute{
@@ -247,27 +398,57 @@ func (r *Disk) Schema(ctx context.Context, req resource.SchemaRequest, resp *res
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers:       []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"clone_from_disk_uuid": schema.StringAttribute{
+				MarkdownDescription: "Disk UUID to clone the disk from. Cloning waits for the copy to finish.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:          []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("external_image_url"),
+						path.MatchRoot("source_image_type"),
+						path.MatchRoot("source_image_uuid"),
+					),
 				},
 			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Disk created at date and time",
 				Computed:            true,
 			},
+			"external_image_url": schema.StringAttribute{
+				MarkdownDescription: "External image URL to import into the disk. Importing waits for the copy to finish.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:          []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("source_image_type"),
+						path.MatchRoot("source_image_uuid"),
+					),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be a HTTP or HTTPS URL"),
+				},
+			},
+			"force_detach": schema.BoolAttribute{
+				MarkdownDescription: "Stop the server the disk is attached to if it is locked while detaching the disk. The server is started again afterwards.",
+				Optional:            true,
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Disk UUID",
 				Computed:            true,
 			},
 			"server_uuid": schema.StringAttribute{
-				MarkdownDescription: "Server UUID disk is attached to",
+				MarkdownDescription: "Server UUID disk is attached to. Leave it unset if the attachment is managed with `warren_disk_attachment`. Unsetting it stops managing the attachment without detaching the disk, import the attachment into a `warren_disk_attachment` resource and destroy it to detach the disk.",
 				Optional:            true,
 			},
 			"size_in_gb": schema.Int64Attribute{
-				MarkdownDescription: "Disk size in GB",
+				MarkdownDescription: "Disk size in GB. Disks are grown in place while shrinking them requires replacement.",
 				Required:            true,
-				PlanModifiers:       []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
 			},
 			"snapshots": schema.ListNestedAttribute{
 				MarkdownDescription: "Disk snapshots",
@@ -294,19 +475,24 @@ func (r *Disk) Schema(ctx context.Context, req resource.SchemaRequest, resp *res
 				},
 			},
 			"source_image_type": schema.StringAttribute{
-				MarkdownDescription: "Disk source image type",
+				MarkdownDescription: fmt.Sprintf("Disk source image type, one of `%s`", strings.Join(diskSourceImageTypes, "`, `")),
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers:       []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators:          []validator.String{
+					stringvalidator.OneOf(diskSourceImageTypes...),
 				},
 			},
 			"source_image_uuid": schema.StringAttribute{
-				MarkdownDescription: "Disk source image UUID",
+				MarkdownDescription: "Disk source image UUID, e.g. a disk snapshot UUID for source image type `SNAPSHOT`",
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers:       []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"status": schema.StringAttribute{
@@ -347,7 +533,8 @@ func (r *Disk) Update(ctx context.Context, req resource.UpdateRequest, resp *res
 		return
 	}
 
-	if !oldData.ServerUUID.Equal(newData.ServerUUID) {
+	// Unsetting "server_uuid" stops managing the attachment without detaching
+	if !newData.ServerUUID.IsNull() && !oldData.ServerUUID.Equal(newData.ServerUUID) {
 		diskUUID := oldData.UUID.ValueString()
 		newServerUUID := newData.ServerUUID.ValueString()
 
@@ -355,14 +542,18 @@ func (r *Disk) Update(ctx context.Context, req resource.UpdateRequest, resp *res
 			oldServerUUID := oldData.ServerUUID.ValueString()
 			tflog.Trace(ctx, fmt.Sprintf("Disk will be detached from server UUID: %s", oldServerUUID))
 
-			err := r.client.VirtualMachine.DetachDisk(oldServerUUID, diskUUID)
+			err := detachDisk(ctx, r.client, oldServerUUID, diskUUID, newData.ForceDetach.ValueBool())
 			if nil != err {
-				resp.Diagnostics.AddError("Disk update error", apis.GetServerErrorFromHttpCallError(err).Error())
+				resp.Diagnostics.AddError("Disk update error", err.Error())
 				return
 			}
 		}
 
-		if "" != newServerUUID {
+		server, _ := apis.GetServerFromVolumeUUID(r.client, diskUUID)
+
+		if nil != server && newServerUUID == server.Uuid {
+			tflog.Trace(ctx, fmt.Sprintf("Disk is already attached to server UUID: %s", newServerUUID))
+		} else {
 			tflog.Trace(ctx, fmt.Sprintf("Disk will be attached to server UUID: %s", newServerUUID))
 
 			_, err := r.client.VirtualMachine.AttachDisk(newServerUUID, diskUUID)
@@ -373,6 +564,35 @@ func (r *Disk) Update(ctx context.Context, req resource.UpdateRequest, resp *res
 		}
 	}
 
+	if !oldData.SizeInGB.Equal(newData.SizeInGB) {
+		diskUUID := oldData.UUID.ValueString()
+		tflog.Trace(ctx, fmt.Sprintf("Disk %s will be resized to %d GB", diskUUID, newData.SizeInGB.ValueInt64()))
+
+		_, err := apis.ResizeVolume(r.client, diskUUID, int(newData.SizeInGB.ValueInt64()))
+		if nil != err {
+			resp.Diagnostics.AddError("Disk update error", err.Error())
+			return
+		}
+
+		_, err = apis.WaitForVolumeResized(ctx, r.client, diskUUID, int(newData.SizeInGB.ValueInt64()), diskResizeTimeout)
+		if nil != err {
+			resp.Diagnostics.AddError("Disk update error", err.Error())
+			return
+		}
+	}
+
+	disk, err := r.client.BlockStorage.GetDiskById(oldData.UUID.ValueString())
+	if nil != err {
+		resp.Diagnostics.AddError("Disk update error", apis.GetVolumeErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	err = r.setStateData(ctx, disk, &newData)
+	if nil != err {
+		resp.Diagnostics.AddError("Disk update error", err.Error())
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newData)...)
 }
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package resources contains all Terraform resources supported
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewDiskAttachment() resource.Resource {
+	return &DiskAttachment{}
+}
+
+func (r *DiskAttachment) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warren.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError("Disk attachment configure error", fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiskAttachment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiskAttachmentModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diskUUID := data.DiskUUID.ValueString()
+	serverUUID := data.ServerUUID.ValueString()
+
+	tflog.Trace(ctx, fmt.Sprintf("Disk %s will be attached to server UUID: %s", diskUUID, serverUUID))
+
+	_, err := r.client.VirtualMachine.AttachDisk(serverUUID, diskUUID)
+	if nil != err {
+		resp.Diagnostics.AddError("Disk attachment create error", apis.GetServerErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	r.setStateData(ctx, serverUUID, diskUUID, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiskAttachment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiskAttachmentModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diskUUID := data.DiskUUID.ValueString()
+	serverUUID := data.ServerUUID.ValueString()
+
+	server, err := apis.GetServerFromVolumeUUID(r.client, diskUUID)
+	if nil != err {
+		if errors.Is(err, apis.ErrServerNotFound) {
+			tflog.Debug(ctx, fmt.Sprintf("Disk has already been detached: %s", diskUUID))
+		} else {
+			resp.Diagnostics.AddError("Disk attachment delete error", err.Error())
+		}
+
+		return
+	}
+
+	if serverUUID != server.Uuid {
+		tflog.Debug(ctx, fmt.Sprintf("Disk %s has already been detached from server UUID: %s", diskUUID, serverUUID))
+		return
+	}
+
+	err = detachDisk(ctx, r.client, serverUUID, diskUUID, false)
+	if nil != err {
+		resp.Diagnostics.AddError("Disk attachment delete error", err.Error())
+	}
+}
+
+func (r *DiskAttachment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, err := parseImportID(ctx, r.client, req.ID)
+	if nil != err {
+		resp.Diagnostics.AddError("Disk attachment import error", err.Error())
+		return
+	}
+
+	if "" != importID.Name {
+		resp.Diagnostics.AddError("Disk attachment import error", "Disk attachments can only be imported by disk UUID as they do not have a name")
+		return
+	}
+
+	server, err := apis.GetServerFromVolumeUUID(importID.Client, importID.UUID)
+	if nil != err {
+		resp.Diagnostics.AddError("Disk attachment import error", err.Error())
+		return
+	}
+
+	data := DiskAttachmentModel{}
+	r.setStateData(ctx, server.Uuid, importID.UUID, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiskAttachment) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk_attachment"
+}
+
+func (r *DiskAttachment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiskAttachmentModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diskUUID := data.DiskUUID.ValueString()
+
+	server, err := apis.GetServerFromVolumeUUID(r.client, diskUUID)
+	if nil != err {
+		if errors.Is(err, apis.ErrServerNotFound) {
+			tflog.Trace(ctx, fmt.Sprintf("Disk has been detached: %s", diskUUID))
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Disk attachment read error", err.Error())
+		}
+
+		return
+	}
+
+	if data.ServerUUID.ValueString() != server.Uuid {
+		tflog.Trace(ctx, fmt.Sprintf("Disk %s has been attached to another server UUID: %s", diskUUID, server.Uuid))
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	r.setStateData(ctx, server.Uuid, diskUUID, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiskAttachment) setStateData(ctx context.Context, serverUUID string, diskUUID string, data *DiskAttachmentModel) error {
+	data.DiskUUID = types.StringValue(diskUUID)
+	data.ID = types.StringValue(diskUUID)
+	data.ServerUUID = types.StringValue(serverUUID)
+
+	return nil
+}
+
+func (r *DiskAttachment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Warren Platform disk attachment to a virtual machine managed independently of the disk",
+
+		Attributes: map[string]schema.Attribute{
+			"disk_uuid": schema.StringAttribute{
+				MarkdownDescription: "Disk UUID to attach",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Disk attachment ID being the attached disk UUID",
+				Computed:            true,
+			},
+			"server_uuid": schema.StringAttribute{
+				MarkdownDescription: "Server UUID to attach the disk to",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DiskAttachment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Disk attachment update error", "Disk attachments can not be updated and are replaced instead")
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package resources contains all Terraform resources supported
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
+)
+
+func generateDiskAttachmentConfig(mockTestEnv mock.MockTestEnv, isAttached bool) string {
+	attachmentConfig := ""
+
+	if isAttached {
+		attachmentConfig = fmt.Sprintf(
+			`
+resource "warren_disk_attachment" "test" {
+	disk_uuid = warren_disk.test.id
+	server_uuid = %q
+}
+			`,
+			mock.TestServerUUID,
+		)
+	}
+
+	return fmt.Sprintf(
+		`
+%s
+
+resource "warren_disk" "test" {
+	size_in_gb = 20
+}
+
+%s
+		`,
+		mockTestEnv.ProviderConfig,
+		attachmentConfig,
+	)
+}
+
+func DiskAttachmentTests(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
+	var mockTestEnv mock.MockTestEnv
+	t := GinkgoT()
+
+	var _ = BeforeEach(func() {
+		mockTestEnv = mock.NewMockTestEnv()
+
+		apis.SetClientForToken("dummy-token", mockTestEnv.Client)
+		mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, false)
+		mock.SetupVMEndpointOnMux(mockTestEnv.Mux, false)
+	})
+
+	var _ = AfterEach(func() {
+		mockTestEnv.Teardown()
+		apis.SetClientForToken("dummy-token", nil)
+	})
+
+	var _ = Describe("DiskAttachment", func() {
+		It("is correctly handled", func() {
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateDiskAttachmentConfig(mockTestEnv, true),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk_attachment.test", "id", mock.TestSecondDiskUUID),
+								resource.TestCheckResourceAttr("warren_disk_attachment.test", "disk_uuid", mock.TestSecondDiskUUID),
+								resource.TestCheckResourceAttr("warren_disk_attachment.test", "server_uuid", mock.TestServerUUID),
+								resource.TestCheckNoResourceAttr("warren_disk.test", "server_uuid"),
+							),
+						},
+						// ImportState testing
+						{
+							Config:            generateDiskAttachmentConfig(mockTestEnv, true),
+							ResourceName:      "warren_disk_attachment.test",
+							ImportState:       true,
+							ImportStateId:     fmt.Sprintf("%s/%s", mockTestEnv.Client.LocationSlug, mock.TestSecondDiskUUID),
+							ImportStateVerify: true,
+						},
+						// Detach testing
+						{
+							Config: generateDiskAttachmentConfig(mockTestEnv, false),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								func(_ *terraform.State) error {
+									_, err := apis.GetServerFromVolumeUUID(mockTestEnv.Client, mock.TestSecondDiskUUID)
+									if nil == err {
+										return fmt.Errorf("Disk %s is still attached", mock.TestSecondDiskUUID)
+									}
+
+									return nil
+								},
+							),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		It("is correctly detached from locked servers", func() {
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateDiskAttachmentConfig(mockTestEnv, true),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk_attachment.test", "server_uuid", mock.TestServerUUID),
+								func(_ *terraform.State) error {
+									// The next detach request is retried as the server is locked
+									mock.LockTestServerDetach(mockTestEnv.Mux, 1)
+									return nil
+								},
+							),
+						},
+						// Detach testing
+						{
+							Config: generateDiskAttachmentConfig(mockTestEnv, false),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								func(_ *terraform.State) error {
+									_, err := apis.GetServerFromVolumeUUID(mockTestEnv.Client, mock.TestSecondDiskUUID)
+									if nil == err {
+										return fmt.Errorf("Disk %s is still attached", mock.TestSecondDiskUUID)
+									}
+
+									return nil
+								},
+							),
+						},
+					},
+				},
+			)
+		})
+
+		Expect(t.Failed()).To(BeFalse())
+	})
+}
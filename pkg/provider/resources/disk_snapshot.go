@@ -0,0 +1,209 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package resources contains all Terraform resources supported
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+func NewDiskSnapshot() resource.Resource {
+	return &DiskSnapshot{}
+}
+
+func (r *DiskSnapshot) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*warren.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError("Disk snapshot configure error", fmt.Sprintf("Expected *warren.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiskSnapshot) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiskSnapshotModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diskUUID := data.DiskUUID.ValueString()
+
+	snapshot, err := apis.CreateSnapshot(r.client, diskUUID)
+	if nil != err {
+		resp.Diagnostics.AddError("Disk snapshot create error", err.Error())
+		return
+	}
+
+	if "" == snapshot.DiskUuid {
+		snapshot.DiskUuid = diskUUID
+	}
+
+	r.setStateData(ctx, snapshot, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiskSnapshot) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiskSnapshotModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshotUUID := data.UUID.ValueString()
+
+	err := apis.DeleteSnapshot(r.client, snapshotUUID)
+	if nil != err {
+		if errors.Is(err, apis.ErrSnapshotNotFound) {
+			tflog.Debug(ctx, fmt.Sprintf("Disk snapshot has already been deleted: %s", snapshotUUID))
+		} else {
+			resp.Diagnostics.AddError("Disk snapshot delete error", err.Error())
+		}
+	}
+}
+
+func (r *DiskSnapshot) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, err := parseImportID(ctx, r.client, req.ID)
+	if nil != err {
+		resp.Diagnostics.AddError("Disk snapshot import error", err.Error())
+		return
+	}
+
+	if "" != importID.Name {
+		resp.Diagnostics.AddError("Disk snapshot import error", "Disk snapshots can only be imported by UUID as they do not have a name")
+		return
+	}
+
+	snapshot, err := apis.GetSnapshotByUUID(importID.Client, importID.UUID)
+	if nil != err {
+		resp.Diagnostics.AddError("Disk snapshot import error", err.Error())
+		return
+	}
+
+	data := DiskSnapshotModel{}
+	r.setStateData(ctx, snapshot, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiskSnapshot) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk_snapshot"
+}
+
+func (r *DiskSnapshot) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiskSnapshotModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshotUUID := data.UUID.ValueString()
+
+	snapshot, err := apis.GetSnapshotByUUID(r.client, snapshotUUID)
+	if errors.Is(err, apis.ErrSnapshotNotFound) {
+		// Snapshots are only listed as part of their source disk
+		_, diskErr := r.client.BlockStorage.GetDiskById(data.DiskUUID.ValueString())
+		diskErr = apis.GetVolumeErrorFromHttpCallError(diskErr)
+
+		if nil == diskErr {
+			tflog.Trace(ctx, fmt.Sprintf("Disk snapshot has been deleted: %s", snapshotUUID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		if errors.Is(diskErr, apis.ErrVolumeNotFound) {
+			err = fmt.Errorf(
+				"Disk snapshot %s can not be read as its source disk %s has been deleted, remove it from the state if it is no longer needed",
+				snapshotUUID,
+				data.DiskUUID.ValueString(),
+			)
+		} else {
+			err = diskErr
+		}
+	}
+
+	if nil != err {
+		resp.Diagnostics.AddError("Disk snapshot read error", err.Error())
+		return
+	}
+
+	r.setStateData(ctx, snapshot, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiskSnapshot) setStateData(ctx context.Context, snapshot *warren.Snapshot, data *DiskSnapshotModel) error {
+	data.CreatedAt = types.StringValue(snapshot.CreatedAt)
+	data.DiskUUID = types.StringValue(snapshot.DiskUuid)
+	data.SizeInGB = types.Int64Value(int64(snapshot.SizeGb))
+	data.UUID = types.StringValue(snapshot.Uuid)
+
+	return nil
+}
+
+func (r *DiskSnapshot) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Warren Platform disk snapshot. Snapshots are only listed as part of their source disk, so snapshots of deleted disks can not be read and need to be removed from the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Disk snapshot created at date and time",
+				Computed:            true,
+			},
+			"disk_uuid": schema.StringAttribute{
+				MarkdownDescription: "Source disk UUID",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Disk snapshot UUID",
+				Computed:            true,
+			},
+			"size_in_gb": schema.Int64Attribute{
+				MarkdownDescription: "Disk snapshot size in GB",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DiskSnapshot) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Disk snapshot update error", "Disk snapshots can not be updated and are replaced instead")
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package resources contains all Terraform resources supported
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
+)
+
+func generateDiskSnapshotConfig(mockTestEnv mock.MockTestEnv) string {
+	return fmt.Sprintf(
+		`
+%s
+
+resource "warren_disk" "source" {
+	size_in_gb = %d
+}
+
+resource "warren_disk_snapshot" "test" {
+	disk_uuid = warren_disk.source.id
+}
+
+resource "warren_disk" "restored" {
+	size_in_gb = warren_disk_snapshot.test.size_in_gb
+	source_image_type = "SNAPSHOT"
+	source_image_uuid = warren_disk_snapshot.test.id
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		mock.TestDiskSizeInGB,
+	)
+}
+
+func DiskSnapshotTests(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
+	var mockTestEnv mock.MockTestEnv
+	t := GinkgoT()
+
+	var _ = BeforeEach(func() {
+		mockTestEnv = mock.NewMockTestEnv()
+
+		apis.SetClientForToken("dummy-token", mockTestEnv.Client)
+		mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, true)
+		mock.SetupVMEndpointOnMux(mockTestEnv.Mux, true)
+	})
+
+	var _ = AfterEach(func() {
+		mockTestEnv.Teardown()
+		apis.SetClientForToken("dummy-token", nil)
+	})
+
+	var _ = Describe("DiskSnapshot", func() {
+		It("is correctly handled", func() {
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateDiskSnapshotConfig(mockTestEnv),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk_snapshot.test", "id", mock.TestDiskSnapshotUUID),
+								resource.TestCheckResourceAttr("warren_disk_snapshot.test", "disk_uuid", mock.TestDiskUUID),
+								resource.TestCheckResourceAttr("warren_disk_snapshot.test", "size_in_gb", fmt.Sprint(mock.TestDiskSizeInGB)),
+								resource.TestCheckResourceAttrSet("warren_disk_snapshot.test", "created_at"),
+								resource.TestCheckResourceAttr("warren_disk.restored", "id", mock.TestSecondDiskUUID),
+								resource.TestCheckResourceAttr("warren_disk.restored", "source_image_type", "SNAPSHOT"),
+								resource.TestCheckResourceAttr("warren_disk.restored", "source_image_uuid", mock.TestDiskSnapshotUUID),
+							),
+						},
+						// Refresh testing
+						{
+							Config: generateDiskSnapshotConfig(mockTestEnv),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk.source", "snapshots.#", "1"),
+								resource.TestCheckResourceAttr("warren_disk.source", "snapshots.0.uuid", mock.TestDiskSnapshotUUID),
+							),
+						},
+						// ImportState testing
+						{
+							Config:            generateDiskSnapshotConfig(mockTestEnv),
+							ResourceName:      "warren_disk_snapshot.test",
+							ImportState:       true,
+							ImportStateId:     fmt.Sprintf("%s/%s", mockTestEnv.Client.LocationSlug, mock.TestDiskSnapshotUUID),
+							ImportStateVerify: true,
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		Expect(t.Failed()).To(BeFalse())
+	})
+}
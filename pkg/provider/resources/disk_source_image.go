@@ -0,0 +1,118 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package resources contains all Terraform resources supported
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+// diskSourceImageTypes contains all disk source image types supported
+var diskSourceImageTypes = []string{
+	string(warren.OS_BASE),
+	string(warren.DISK),
+	string(warren.SNAPSHOT),
+	string(warren.EXTERNAL),
+	string(warren.EMPTY),
+}
+
+// diskSourceImageValidator validates the combination of the disk source image
+// type and UUID configured.
+type diskSourceImageValidator struct{}
+
+func (v diskSourceImageValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v diskSourceImageValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf(
+		"`source_image_uuid` is required for source image types `%s` and `%s` and must not be set for `%s`",
+		warren.DISK,
+		warren.SNAPSHOT,
+		warren.EMPTY,
+	)
+}
+
+func (v diskSourceImageValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		sourceImageType types.String
+		sourceImageUUID types.String
+	)
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_image_type"), &sourceImageType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_image_uuid"), &sourceImageUUID)...)
+
+	if resp.Diagnostics.HasError() || sourceImageType.IsNull() || sourceImageType.IsUnknown() {
+		return
+	}
+
+	switch warren.SourceImageType(sourceImageType.ValueString()) {
+	case warren.DISK, warren.SNAPSHOT:
+		if sourceImageUUID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_image_uuid"),
+				"Missing disk source image UUID",
+				fmt.Sprintf("Source image UUID is required for source image type %s", sourceImageType.ValueString()),
+			)
+		}
+	case warren.EMPTY:
+		if !sourceImageUUID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_image_uuid"),
+				"Invalid disk source image UUID",
+				fmt.Sprintf("Source image UUID must not be set for source image type %s", warren.EMPTY),
+			)
+		}
+	}
+}
+
+// getDiskSourceImageError returns an error if the source image referenced
+// does not exist. Source images not known yet are not checked.
+//
+// PARAMETERS
+// client          *warren.Client Warren client
+// sourceImageType types.String   Source image type planned
+// sourceImageUUID types.String   Source image UUID planned
+func getDiskSourceImageError(client *warren.Client, sourceImageType types.String, sourceImageUUID types.String) error {
+	if sourceImageType.IsNull() || sourceImageType.IsUnknown() || sourceImageUUID.IsNull() || sourceImageUUID.IsUnknown() {
+		return nil
+	}
+
+	uuid := sourceImageUUID.ValueString()
+
+	switch warren.SourceImageType(sourceImageType.ValueString()) {
+	case warren.DISK:
+		_, err := client.BlockStorage.GetDiskById(uuid)
+		err = apis.GetVolumeErrorFromHttpCallError(err)
+
+		if errors.Is(err, apis.ErrVolumeNotFound) {
+			return fmt.Errorf("Source disk not found: %s", uuid)
+		}
+
+		return err
+	case warren.SNAPSHOT:
+		_, err := apis.GetSnapshotByUUID(client, uuid)
+
+		if errors.Is(err, apis.ErrSnapshotNotFound) {
+			return fmt.Errorf("Source snapshot not found: %s", uuid)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -10,10 +10,15 @@ obtain one at http://mozilla.org/MPL/2.0/.
 package resources
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
@@ -21,20 +26,132 @@ import (
 )
 
 func generateDiskConfig(mockTestEnv mock.MockTestEnv) string {
+	return generateDiskConfigWithSize(mockTestEnv, mock.TestDiskSizeInGB)
+}
+
+func generateDiskConfigWithSize(mockTestEnv mock.MockTestEnv, sizeInGB int) string {
+	return fmt.Sprintf(
+		`
+%s
+
+resource "warren_disk" "test" {
+	server_uuid = %q
+	size_in_gb = %d
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		mock.TestServerUUID,
+		sizeInGB,
+	)
+}
+
+func generateDiskConfigWithoutServerUUID(mockTestEnv mock.MockTestEnv) string {
+	return fmt.Sprintf(
+		`
+%s
+
+resource "warren_disk" "test" {
+	size_in_gb = %d
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		mock.TestDiskSizeInGB,
+	)
+}
+
+func generateDiskConfigWithForceDetach(mockTestEnv mock.MockTestEnv) string {
 	return fmt.Sprintf(
 		`
 %s
 
 resource "warren_disk" "test" {
+	force_detach = true
 	server_uuid = %q
-	size_in_gb = 20
+	size_in_gb = %d
 }
 		`,
 		mockTestEnv.ProviderConfig,
 		mock.TestServerUUID,
+		mock.TestDiskSizeInGB,
+	)
+}
+
+func generateDiskCloneConfig(mockTestEnv mock.MockTestEnv) string {
+	return fmt.Sprintf(
+		`
+%s
+
+resource "warren_disk" "source" {
+	size_in_gb = %d
+}
+
+resource "warren_disk" "test" {
+	clone_from_disk_uuid = warren_disk.source.id
+	size_in_gb = warren_disk.source.size_in_gb
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		mock.TestDiskSizeInGB,
+	)
+}
+
+func generateDiskConfigWithExternalImageURL(mockTestEnv mock.MockTestEnv, externalImageURL string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+resource "warren_disk" "test" {
+	external_image_url = %q
+	size_in_gb = %d
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		externalImageURL,
+		mock.TestDiskSizeInGB,
+	)
+}
+
+func generateDiskConfigWithSourceImage(mockTestEnv mock.MockTestEnv, sourceImageType string, sourceImageUUID string) string {
+	sourceImageUUIDConfig := ""
+
+	if "" != sourceImageUUID {
+		sourceImageUUIDConfig = fmt.Sprintf("source_image_uuid = %q", sourceImageUUID)
+	}
+
+	return fmt.Sprintf(
+		`
+%s
+
+resource "warren_disk" "test" {
+	size_in_gb = %d
+	source_image_type = %q
+	%s
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		mock.TestDiskSizeInGB,
+		sourceImageType,
+		sourceImageUUIDConfig,
 	)
 }
 
+func checkDiskDetachedFromTestServer(mockTestEnv mock.MockTestEnv) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		server, err := mockTestEnv.Client.VirtualMachine.GetByUuid(mock.TestServerUUID)
+		if nil != err {
+			return err
+		}
+
+		for _, storage := range server.Storage {
+			if mock.TestDiskUUID == storage.Uuid {
+				return fmt.Errorf("Disk %s is still attached to server UUID: %s", mock.TestDiskUUID, mock.TestServerUUID)
+			}
+		}
+
+		return nil
+	}
+}
+
 func DiskTests(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
 	var mockTestEnv mock.MockTestEnv
 	t := GinkgoT()
@@ -76,6 +193,29 @@ func DiskTests(providerFactories map[string]func() (tfprotov6.ProviderServer, er
 			)
 		})
 
+		It("is correctly imported with location", func() {
+			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, false)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// ImportState testing
+						{
+							Config:        generateDiskConfig(mockTestEnv),
+							ResourceName:  "warren_disk.test",
+							ImportState:   true,
+							ImportStateId: fmt.Sprintf("%s/%s", mockTestEnv.Client.LocationSlug, mock.TestDiskUUID),
+							Check:         resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk.test", "id", mock.TestDiskUUID),
+							),
+						},
+					},
+				},
+			)
+		})
+
 		It("is correctly handled", func() {
 			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, true)
 
@@ -97,6 +237,274 @@ func DiskTests(providerFactories map[string]func() (tfprotov6.ProviderServer, er
 			)
 		})
 
+		It("is correctly reporting creation failures", func() {
+			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, true)
+			mock.FailTestDiskCreation(mockTestEnv.Mux, "Storage backend unavailable")
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create testing
+						{
+							Config:      generateDiskConfig(mockTestEnv),
+							ExpectError: regexp.MustCompile("Storage backend unavailable"),
+						},
+					},
+				},
+			)
+		})
+
+		It("is correctly cloned", func() {
+			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, true)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateDiskCloneConfig(mockTestEnv),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk.test", "id", mock.TestSecondDiskUUID),
+								resource.TestCheckResourceAttr("warren_disk.test", "clone_from_disk_uuid", mock.TestDiskUUID),
+								resource.TestCheckResourceAttr("warren_disk.test", "source_image_type", "DISK"),
+								resource.TestCheckResourceAttr("warren_disk.test", "source_image_uuid", mock.TestDiskUUID),
+								resource.TestCheckResourceAttr("warren_disk.test", "status", "Active"),
+							),
+						},
+						// Refresh testing
+						{
+							Config:   generateDiskCloneConfig(mockTestEnv),
+							PlanOnly: true,
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		It("is correctly created from external images", func() {
+			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, true)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Invalid external image URL testing
+						{
+							Config:      generateDiskConfigWithExternalImageURL(mockTestEnv, "ftp://images.example.com/test-disk.qcow2"),
+							ExpectError: regexp.MustCompile("must be a HTTP or HTTPS URL"),
+						},
+						// Create and Read testing
+						{
+							Config: generateDiskConfigWithExternalImageURL(mockTestEnv, mock.TestDiskExternalImageURL),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk.test", "id", mock.TestDiskUUID),
+								resource.TestCheckResourceAttr("warren_disk.test", "external_image_url", mock.TestDiskExternalImageURL),
+								resource.TestCheckResourceAttr("warren_disk.test", "source_image_type", "EXTERNAL"),
+								resource.TestCheckResourceAttr("warren_disk.test", "status", "Active"),
+							),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		It("is correctly validating source images", func() {
+			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, false)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Unsupported source image type testing
+						{
+							Config:      generateDiskConfigWithSourceImage(mockTestEnv, "UNKNOWN", ""),
+							ExpectError: regexp.MustCompile("value must be one of"),
+						},
+						// Missing source image UUID testing
+						{
+							Config:      generateDiskConfigWithSourceImage(mockTestEnv, "SNAPSHOT", ""),
+							ExpectError: regexp.MustCompile("Source image UUID is required"),
+						},
+						// Source image UUID for empty disks testing
+						{
+							Config:      generateDiskConfigWithSourceImage(mockTestEnv, "EMPTY", mock.TestDiskUUID),
+							ExpectError: regexp.MustCompile("Source image UUID must not be set"),
+						},
+						// Source image not found testing
+						{
+							Config:      generateDiskConfigWithSourceImage(mockTestEnv, "SNAPSHOT", mock.TestDiskSnapshotUUID),
+							ExpectError: regexp.MustCompile("Source snapshot not found"),
+						},
+						{
+							Config:      generateDiskConfigWithSourceImage(mockTestEnv, "DISK", mock.TestSecondDiskUUID),
+							ExpectError: regexp.MustCompile("Source disk not found"),
+						},
+					},
+				},
+			)
+		})
+
+		It("is correctly detached before deletion", func() {
+			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, true)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					CheckDestroy:             checkDiskDetachedFromTestServer(mockTestEnv),
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateDiskConfig(mockTestEnv),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk.test", "server_uuid", mock.TestServerUUID),
+								func(state *terraform.State) error {
+									// The next detach request is retried as the server is locked
+									mock.LockTestServerDetach(mockTestEnv.Mux, 1)
+									return nil
+								},
+							),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		It("is not detached if the server UUID is unset", func() {
+			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, true)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					CheckDestroy:             checkDiskDetachedFromTestServer(mockTestEnv),
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateDiskConfig(mockTestEnv),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk.test", "server_uuid", mock.TestServerUUID),
+							),
+						},
+						// Unset server UUID testing
+						{
+							Config: generateDiskConfigWithoutServerUUID(mockTestEnv),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckNoResourceAttr("warren_disk.test", "server_uuid"),
+								func(_ *terraform.State) error {
+									server, err := apis.GetServerFromVolumeUUID(mockTestEnv.Client, mock.TestDiskUUID)
+									if nil != err {
+										return err
+									}
+
+									if mock.TestServerUUID != server.Uuid {
+										return fmt.Errorf("Disk %s is not attached to server UUID: %s", mock.TestDiskUUID, mock.TestServerUUID)
+									}
+
+									return nil
+								},
+							),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		It("is correctly force detached before deletion", func() {
+			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, true)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					CheckDestroy:             checkDiskDetachedFromTestServer(mockTestEnv),
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateDiskConfigWithForceDetach(mockTestEnv),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk.test", "force_detach", "true"),
+								func(state *terraform.State) error {
+									// The server stays locked until it is stopped
+									mock.LockTestServerDetach(mockTestEnv.Mux, 1000)
+									return nil
+								},
+							),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		It("is correctly resized", func() {
+			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, true)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateDiskConfig(mockTestEnv),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk.test", "size_in_gb", fmt.Sprint(mock.TestDiskSizeInGB)),
+							),
+						},
+						// Grow in place testing
+						{
+							Config:           generateDiskConfigWithSize(mockTestEnv, 30),
+							ConfigPlanChecks: resource.ConfigPlanChecks{
+								PreApply: []plancheck.PlanCheck{
+									plancheck.ExpectResourceAction("warren_disk.test", plancheck.ResourceActionUpdate),
+								},
+							},
+							Check:            resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk.test", "id", mock.TestDiskUUID),
+								resource.TestCheckResourceAttr("warren_disk.test", "size_in_gb", "30"),
+							),
+						},
+						// Resized outside of Terraform testing
+						{
+							PreConfig: func() {
+								_, err := apis.ResizeVolume(mockTestEnv.Client, mock.TestDiskUUID, 40)
+								Expect(err).ToNot(HaveOccurred())
+
+								_, err = apis.WaitForVolumeResized(context.Background(), mockTestEnv.Client, mock.TestDiskUUID, 40, time.Minute)
+								Expect(err).ToNot(HaveOccurred())
+							},
+							Config:    generateDiskConfigWithSize(mockTestEnv, 40),
+							PlanOnly:  true,
+						},
+						// Shrink testing
+						{
+							Config:           generateDiskConfigWithSize(mockTestEnv, 10),
+							ConfigPlanChecks: resource.ConfigPlanChecks{
+								PreApply: []plancheck.PlanCheck{
+									plancheck.ExpectResourceAction("warren_disk.test", plancheck.ResourceActionDestroyBeforeCreate),
+								},
+							},
+							Check:            resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_disk.test", "size_in_gb", "10"),
+							),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
 		Expect(t.Failed()).To(BeFalse())
 	})
 }
@@ -16,7 +16,6 @@ import (
 	"net"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -95,7 +94,7 @@ func (r *FloatingIP) create(ctx context.Context, req resource.CreateRequest, dat
 			return apis.GetFloatingIPErrorFromHttpCallError(err)
 		}
 
-		resultData.FloatingIPID = floatingIP.Id
+		resultData.FloatingIPUUID = floatingIP.Uuid
 	}
 
 	floatingIPName := data.Name.ValueString()
@@ -145,8 +144,8 @@ func (r *FloatingIP) create(ctx context.Context, req resource.CreateRequest, dat
 func (r *FloatingIP) createOnErrorCleanup(ctx context.Context, req resource.CreateRequest, err error) {
 	resultData := ctx.Value(ctxWrapDataKey("MethodData")).(*floatingIPCreateMethodData)
 
-	if resultData.FloatingIPID != 0 {
-		floatingIP, _ := apis.GetFloatingIPByID(r.client, resultData.FloatingIPID)
+	if resultData.FloatingIPUUID != "" {
+		floatingIP, _ := apis.GetFloatingIPByUUID(r.client, resultData.FloatingIPUUID)
 		if nil != floatingIP {
 			_ = r.client.Network.DeleteFloatingIp(net.ParseIP(floatingIP.Address))
 		}
@@ -162,16 +161,12 @@ func (r *FloatingIP) Delete(ctx context.Context, req resource.DeleteRequest, res
 		return
 	}
 
-	floatingIPID, err := strconv.Atoi(data.ID.ValueString())
-	if nil != err {
-		resp.Diagnostics.AddError("Floating IP delete error", err.Error())
-		return
-	}
+	floatingIPUUID := data.ID.ValueString()
 
-	floatingIP, err := apis.GetFloatingIPByID(r.client, floatingIPID)
+	floatingIP, err := apis.GetFloatingIPByUUID(r.client, floatingIPUUID)
 	if nil != err {
 		if errors.Is(err, apis.ErrFloatingIPNotFound) {
-			tflog.Debug(ctx, fmt.Sprintf("Floating IP has already been deleted: %s", floatingIPID))
+			tflog.Debug(ctx, fmt.Sprintf("Floating IP has already been deleted: %s", floatingIPUUID))
 		} else {
 			resp.Diagnostics.AddError("Floating IP delete error", err.Error())
 		}
@@ -186,22 +181,25 @@ func (r *FloatingIP) Delete(ctx context.Context, req resource.DeleteRequest, res
 }
 
 func (r *FloatingIP) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	floatingIPID, err := strconv.Atoi(req.ID)
-	if nil != err {
-		resp.Diagnostics.AddError("Floating IP import error", err.Error())
+	var (
+		err        error
+		floatingIP *warren.FloatingIp
+	)
+
+	// Floating IPs are imported by address, UUID or the internal numeric ID
+	if address := net.ParseIP(req.ID); nil != address {
+		floatingIP, err = apis.GetFloatingIPByAddress(r.client, address)
+	} else if floatingIPID, convErr := strconv.Atoi(req.ID); nil == convErr {
+		floatingIP, err = apis.GetFloatingIPByID(r.client, floatingIPID)
+	} else {
+		floatingIP, err = apis.GetFloatingIPByUUID(r.client, req.ID)
 	}
 
-	floatingIP, err := apis.GetFloatingIPByID(r.client, floatingIPID)
 	if nil != err {
 		resp.Diagnostics.AddError("Floating IP import error", err.Error())
 		return
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	data := FloatingIPModel{}
 	r.setStateData(ctx, floatingIP, &data)
 
@@ -244,16 +242,12 @@ func (r *FloatingIP) Read(ctx context.Context, req resource.ReadRequest, resp *r
 		return
 	}
 
-	floatingIPID, err := strconv.Atoi(data.ID.ValueString())
-	if nil != err {
-		resp.Diagnostics.AddError("Floating IP read error", err.Error())
-		return
-	}
+	floatingIPUUID := data.ID.ValueString()
 
-	floatingIP, err := apis.GetFloatingIPByID(r.client, floatingIPID)
+	floatingIP, err := apis.GetFloatingIPByUUID(r.client, floatingIPUUID)
 	if nil != err {
 		if errors.Is(err, apis.ErrFloatingIPNotFound) {
-			tflog.Trace(ctx, fmt.Sprintf("Floating IP has been deleted: %s", floatingIPID))
+			tflog.Trace(ctx, fmt.Sprintf("Floating IP has been deleted: %s", floatingIPUUID))
 			resp.State.RemoveResource(ctx)
 		} else {
 			resp.Diagnostics.AddError("Floating IP read error", err.Error())
@@ -276,7 +270,7 @@ func (r *FloatingIP) setStateData(ctx context.Context, floatingIP *warren.Floati
 	data.BillingAccount = types.Int64Value(int64(floatingIP.BillingAccountId))
 	data.CreatedAt = types.StringValue(floatingIP.CreatedAt)
 	data.Enabled = types.BoolValue(floatingIP.Enabled)
-	data.ID = types.StringValue(strconv.Itoa(floatingIP.Id))
+	data.ID = types.StringValue(floatingIP.Uuid)
 	data.IsIPv6 = types.BoolValue(floatingIP.IsIPv6)
 	data.Name = types.StringValue(floatingIP.Name)
 	data.Type = types.StringValue(floatingIP.Type)
@@ -336,7 +330,7 @@ func (r *FloatingIP) Schema(ctx context.Context, req resource.SchemaRequest, res
 				Computed:            true,
 			},
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Floating IP ID",
+				MarkdownDescription: "Floating IP UUID",
 				Computed:            true,
 			},
 			"is_ipv6": schema.BoolAttribute{
@@ -369,9 +363,11 @@ func (r *FloatingIP) Schema(ctx context.Context, req resource.SchemaRequest, res
 			},
 			"uuid": schema.StringAttribute{
 				MarkdownDescription: "Floating IP UUID",
+				DeprecationMessage:  "Use the id attribute instead",
 				Computed:            true,
 			},
 		},
+		Version: 1,
 	}
 }
 
@@ -394,13 +390,7 @@ func (r *FloatingIP) Update(ctx context.Context, req resource.UpdateRequest, res
 	}
 
 	if !oldData.AssignedTo.Equal(newData.AssignedTo) {
-		floatingIPID, err := strconv.Atoi(oldData.ID.ValueString())
-		if nil != err {
-			resp.Diagnostics.AddError("Floating IP update error", err.Error())
-			return
-		}
-
-		floatingIP, err := apis.GetFloatingIPByID(r.client, floatingIPID)
+		floatingIP, err := apis.GetFloatingIPByUUID(r.client, oldData.ID.ValueString())
 		if nil != err {
 			resp.Diagnostics.AddError("Floating IP update error", err.Error())
 			return
@@ -436,3 +426,71 @@ func (r *FloatingIP) Update(ctx context.Context, req resource.UpdateRequest, res
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newData)...)
 }
+
+func (r *FloatingIP) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 used the numeric floating IP ID as "id"
+		0: {
+			PriorSchema:   floatingIPSchemaV0(),
+			StateUpgrader: upgradeFloatingIPStateV0,
+		},
+	}
+}
+
+// floatingIPSchemaV0 returns the floating IP schema of version 0
+func floatingIPSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"assigned_to":               schema.StringAttribute{ Computed: true, Optional: true },
+			"assigned_to_private_ip":    schema.StringAttribute{ Computed: true },
+			"assigned_to_resource_type": schema.StringAttribute{ Computed: true },
+			"address":                   schema.StringAttribute{ Computed: true },
+			"billing_account":           schema.Int64Attribute{ Computed: true, Optional: true },
+			"created_at":                schema.StringAttribute{ Computed: true },
+			"enabled":                   schema.BoolAttribute{ Computed: true },
+			"id":                        schema.StringAttribute{ Computed: true },
+			"is_ipv6":                   schema.BoolAttribute{ Computed: true },
+			"name":                      schema.StringAttribute{ Computed: true, Optional: true },
+			"type":                      schema.StringAttribute{ Computed: true },
+			"network_uuid":              schema.StringAttribute{ Computed: true },
+			"updated_at":                schema.StringAttribute{ Computed: true },
+			"user_id":                   schema.Int64Attribute{ Computed: true },
+			"uuid":                      schema.StringAttribute{ Computed: true },
+		},
+	}
+}
+
+// upgradeFloatingIPStateV0 upgrades the floating IP state from version 0
+//
+// PARAMETERS
+// ctx  context.Context                Execution context
+// req  resource.UpgradeStateRequest   Request with the prior state
+// resp *resource.UpgradeStateResponse Response with the upgraded state
+func upgradeFloatingIPStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var priorData FloatingIPModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := FloatingIPModel{
+		AssignedTo:             priorData.AssignedTo,
+		AssignedToPrivateIP:    priorData.AssignedToPrivateIP,
+		AssignedToResourceType: priorData.AssignedToResourceType,
+		Address:                priorData.Address,
+		BillingAccount:         priorData.BillingAccount,
+		CreatedAt:              priorData.CreatedAt,
+		Enabled:                priorData.Enabled,
+		ID:                     priorData.UUID,
+		IsIPv6:                 priorData.IsIPv6,
+		Name:                   priorData.Name,
+		NetworkUUID:            priorData.NetworkUUID,
+		Type:                   priorData.Type,
+		UpdatedAt:              priorData.UpdatedAt,
+		UserID:                 priorData.UserID,
+		UUID:                   priorData.UUID,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -10,11 +10,17 @@ obtain one at http://mozilla.org/MPL/2.0/.
 package resources
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
+	frameworkResource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
@@ -78,6 +84,38 @@ func FloatingIPTests(providerFactories map[string]func() (tfprotov6.ProviderServ
 			)
 		})
 
+		DescribeTable(
+			"is correctly imported by",
+			func(importID string) {
+				mock.SetupIPAddressesEndpointOnMux(mockTestEnv.Mux, false)
+
+				resource.UnitTest(
+					t,
+					resource.TestCase{
+						ProtoV6ProviderFactories: providerFactories,
+						Steps: []resource.TestStep{
+							// ImportState testing
+							{
+								Config:        generateFloatingIPConfig(mockTestEnv),
+								ResourceName:  "warren_floating_ip.test",
+								ImportState:   true,
+								ImportStateId: importID,
+								ImportStateCheck: func(states []*terraform.InstanceState) error {
+									if len(states) != 1 || states[0].ID != mock.TestFloatingIPUUID {
+										return fmt.Errorf("Floating IP state not normalized to UUID: %v", states)
+									}
+
+									return nil
+								},
+							},
+						},
+					},
+				)
+			},
+			Entry("address", mock.TestFloatingIP),
+			Entry("UUID", mock.TestFloatingIPUUID),
+		)
+
 		It("is correctly handled", func() {
 			mock.SetupIPAddressesEndpointOnMux(mockTestEnv.Mux, true)
 
@@ -99,6 +137,58 @@ func FloatingIPTests(providerFactories map[string]func() (tfprotov6.ProviderServ
 			)
 		})
 
+		It("upgrades state from schema version 0", func() {
+			var (
+				data       FloatingIPModel
+				schemaResp frameworkResource.SchemaResponse
+			)
+
+			ctx := context.Background()
+			r := NewFloatingIP().(*FloatingIP)
+			r.Schema(ctx, frameworkResource.SchemaRequest{}, &schemaResp)
+
+			stateUpgrader := r.UpgradeState(ctx)[0]
+			priorSchema := stateUpgrader.PriorSchema
+
+			priorState := tfsdk.State{
+				Raw:    tftypes.NewValue(priorSchema.Type().TerraformType(ctx), nil),
+				Schema: *priorSchema,
+			}
+
+			Expect(priorState.Set(ctx, &FloatingIPModelV0{
+				AssignedTo:             types.StringValue(mock.TestServerUUID),
+				AssignedToPrivateIP:    types.StringValue("10.42.0.1"),
+				AssignedToResourceType: types.StringValue(NetworkAssignedToResourceVM),
+				Address:                types.StringValue(mock.TestFloatingIP),
+				BillingAccount:         types.Int64Value(6),
+				CreatedAt:              types.StringValue("2019-10-31 10:52:19"),
+				Enabled:                types.BoolValue(true),
+				ID:                     types.StringValue(strconv.Itoa(mock.TestFloatingIPID)),
+				IsIPv6:                 types.BoolValue(false),
+				Name:                   types.StringValue("test"),
+				NetworkUUID:            types.StringValue(mock.TestNetworkUUID),
+				Type:                   types.StringValue("public"),
+				UpdatedAt:              types.StringValue("2019-11-01 10:22:19"),
+				UserID:                 types.Int64Value(8),
+				UUID:                   types.StringValue(mock.TestFloatingIPUUID),
+			})).To(BeEmpty())
+
+			upgradeResp := frameworkResource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+					Schema: schemaResp.Schema,
+				},
+			}
+
+			stateUpgrader.StateUpgrader(ctx, frameworkResource.UpgradeStateRequest{ State: &priorState }, &upgradeResp)
+			Expect(upgradeResp.Diagnostics).To(BeEmpty())
+
+			Expect(upgradeResp.State.Get(ctx, &data)).To(BeEmpty())
+			Expect(data.ID.ValueString()).To(Equal(mock.TestFloatingIPUUID))
+			Expect(data.UUID.ValueString()).To(Equal(mock.TestFloatingIPUUID))
+			Expect(data.Address.ValueString()).To(Equal(mock.TestFloatingIP))
+		})
+
 		Expect(t.Failed()).To(BeFalse())
 	})
 }
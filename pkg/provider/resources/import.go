@@ -0,0 +1,65 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package resources contains all Terraform resources supported
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
+)
+
+// importIDNamePrefix is the import ID prefix to look up a resource by name
+const importIDNamePrefix = "name:"
+
+// importID describes a parsed import ID
+type importID struct {
+	Client *warren.Client
+	Name   string
+	UUID   string
+}
+
+// parseImportID parses the import ID given. Supported formats are "<uuid>",
+// "name:<name>" and both of them prefixed with "<location-slug>/". The client
+// returned is reconfigured for the location slug given.
+//
+// PARAMETERS
+// ctx    context.Context Execution context
+// client *warren.Client  Warren client of the provider
+// id     string          Import ID
+func parseImportID(ctx context.Context, client *warren.Client, id string) (*importID, error) {
+	result := &importID{ Client: client }
+
+	if !strings.HasPrefix(id, importIDNamePrefix) && strings.Contains(id, "/") {
+		idData := strings.SplitN(id, "/", 2)
+		location := idData[0]
+
+		if "" == location {
+			return nil, fmt.Errorf("Invalid import ID without location slug: %s", id)
+		}
+
+		result.Client = apis.GetReconfiguredClientForLocation(ctx, client, location)
+		id = idData[1]
+	}
+
+	if strings.HasPrefix(id, importIDNamePrefix) {
+		result.Name = id[len(importIDNamePrefix):]
+	} else {
+		result.UUID = id
+	}
+
+	if "" == result.Name && "" == result.UUID {
+		return nil, fmt.Errorf("Invalid import ID without name or UUID: %s", id)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package resources contains all Terraform resources supported
+package resources
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/go-client/warren"
+)
+
+func ImportTests() {
+	var _ = Describe("Import ID", func() {
+		client, err := (&warren.ClientBuilder{}).ApiUrl("https://api.invalid/v1").ApiToken("dummy-token").LocationSlug("cyc01").Build()
+		if nil != err {
+			panic(err)
+		}
+
+		DescribeTable(
+			"is correctly parsed",
+			func(id, expectedLocation, expectedName, expectedUUID string) {
+				importID, err := parseImportID(context.Background(), client, id)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(importID.Client.LocationSlug).To(Equal(expectedLocation))
+				Expect(importID.Name).To(Equal(expectedName))
+				Expect(importID.UUID).To(Equal(expectedUUID))
+			},
+			Entry("with UUID", "01234567-89ab-4def-0123-c56789abcdef", "cyc01", "", "01234567-89ab-4def-0123-c56789abcdef"),
+			Entry("with name", "name:web/01", "cyc01", "web/01", ""),
+			Entry("with location and UUID", "cyc01/01234567-89ab-4def-0123-c56789abcdef", "cyc01", "", "01234567-89ab-4def-0123-c56789abcdef"),
+			Entry("with location and name", "cyc01/name:web", "cyc01", "web", ""),
+			Entry("with another location and UUID", "tll01/01234567-89ab-4def-0123-c56789abcdef", "tll01", "", "01234567-89ab-4def-0123-c56789abcdef"),
+			Entry("with another location and name", "tll01/name:web", "tll01", "web", ""),
+		)
+
+		DescribeTable(
+			"is correctly rejected",
+			func(id string) {
+				_, err := parseImportID(context.Background(), client, id)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("with empty name", "name:"),
+			Entry("with empty location", "/01234567-89ab-4def-0123-c56789abcdef"),
+			Entry("with empty UUID", "cyc01/"),
+		)
+
+		It("is correctly parsed with a location for a provider without location", func() {
+			client, err := (&warren.ClientBuilder{}).ApiUrl("https://api.invalid/v1").ApiToken("dummy-token").Build()
+			Expect(err).NotTo(HaveOccurred())
+
+			importID, err := parseImportID(context.Background(), client, "cyc01/01234567-89ab-4def-0123-c56789abcdef")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(importID.Client.LocationSlug).To(Equal("cyc01"))
+			Expect(importID.Client.BaseURL.String()).To(Equal(client.BaseURL.String()))
+		})
+	})
+}
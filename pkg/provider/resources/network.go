@@ -63,6 +63,16 @@ func (r *Network) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
+	if data.IsDefault.ValueBool() && !network.IsDefault {
+		tflog.Trace(ctx, fmt.Sprintf("Network will be set as default: %s", network.Uuid))
+
+		network, err = r.client.Network.ChangeNetworkToDefault(network.Uuid)
+		if nil != err {
+			resp.Diagnostics.AddError("Network create error", apis.GetNetworkErrorFromHttpCallError(err).Error())
+			return
+		}
+	}
+
 	warren.NetworkSetStateData(network, &data)
 
 	// Save data into Terraform state
@@ -100,13 +110,23 @@ func (r *Network) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 }
 
 func (r *Network) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	network, err := r.client.Network.GetNetworkByUUID(req.ID)
+	importID, err := parseImportID(ctx, r.client, req.ID)
 	if nil != err {
-		resp.Diagnostics.AddError("Network import error", apis.GetNetworkErrorFromHttpCallError(err).Error())
+		resp.Diagnostics.AddError("Network import error", err.Error())
+		return
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-	if resp.Diagnostics.HasError() {
+	var network *warrenClient.Network
+
+	if "" != importID.Name {
+		network, err = apis.GetNetworkByName(importID.Client, importID.Name)
+	} else {
+		network, err = importID.Client.Network.GetNetworkByUUID(importID.UUID)
+		err = apis.GetNetworkErrorFromHttpCallError(err)
+	}
+
+	if nil != err {
+		resp.Diagnostics.AddError("Network import error", err.Error())
 		return
 	}
 
@@ -121,21 +141,95 @@ func (r *Network) Metadata(ctx context.Context, req resource.MetadataRequest, re
 	resp.TypeName = req.ProviderTypeName + "_network"
 }
 
+func (r *Network) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Nothing to do for resource instance destruction
+		return
+	}
+
+	var (
+		planData  warren.NetworkModel
+		stateData warren.NetworkModel
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		// Read Terraform prior state data into the model
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if planData.IsDefault.IsNull() || planData.IsDefault.IsUnknown() {
+		return
+	}
+
+	if stateData.IsDefault.ValueBool() && !planData.IsDefault.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("is_default"),
+			"Network default state error",
+			"The default network can not be unset. Set another network as default instead.",
+		)
+
+		return
+	}
+
+	if !planData.IsDefault.ValueBool() || stateData.IsDefault.ValueBool() || nil == r.client {
+		return
+	}
+
+	networks, err := r.client.Network.ListNetworks()
+	if nil != err {
+		resp.Diagnostics.AddError("Network plan error", apis.GetNetworkErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	for _, network := range *networks {
+		if network.IsDefault && !stateData.UUID.Equal(types.StringValue(network.Uuid)) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("is_default"),
+				"Default network will change",
+				fmt.Sprintf(
+					"Network %s (%s) will no longer be the default network. If it is managed by Terraform with `is_default = true` its configuration should be updated.",
+					network.Name,
+					network.Uuid,
+				),
+			)
+		}
+	}
+}
+
 func (r *Network) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data warren.NetworkModel
 
-	// Read Terraform configuration data into the model
+	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := warren.NetworkReadData(r.client, &data)
+	network, err := r.client.Network.GetNetworkByUUID(data.UUID.ValueString())
 	if nil != err {
-		resp.Diagnostics.AddError("Network read error", err.Error())
+		err = apis.GetNetworkErrorFromHttpCallError(err)
+
+		if errors.Is(err, apis.ErrNetworkNotFound) {
+			tflog.Trace(ctx, fmt.Sprintf("Network has been deleted: %s", data.UUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Network read error", err.Error())
+		}
+
 		return
 	}
 
+	warren.NetworkSetStateData(network, &data)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -154,8 +248,9 @@ func (r *Network) Schema(ctx context.Context, req resource.SchemaRequest, resp *
 				Computed:            true,
 			},
 			"is_default": schema.BoolAttribute{
-				MarkdownDescription: "Network set as default",
+				MarkdownDescription: "Network set as default. Virtual machines created without a network UUID are placed into the default network. Setting it removes the default flag from the previous default network while it can not be unset directly. Setting it on more than one network lets the default network change on every apply.",
 				Computed:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Network name",
@@ -219,6 +314,18 @@ func (r *Network) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		warren.NetworkSetStateData(network, &newData)
 	}
 
+	if newData.IsDefault.ValueBool() && !oldData.IsDefault.ValueBool() {
+		tflog.Trace(ctx, fmt.Sprintf("Network will be set as default: %s", oldData.UUID.ValueString()))
+
+		network, err := r.client.Network.ChangeNetworkToDefault(oldData.UUID.ValueString())
+		if nil != err {
+			resp.Diagnostics.AddError("Network update error", apis.GetNetworkErrorFromHttpCallError(err).Error())
+			return
+		}
+
+		warren.NetworkSetStateData(network, &newData)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newData)...)
 }
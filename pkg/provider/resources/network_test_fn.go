@@ -10,12 +10,21 @@ obtain one at http://mozilla.org/MPL/2.0/.
 package resources
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 
+	frameworkResource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis/mock"
 )
@@ -34,6 +43,21 @@ resource "warren_network" "test" {
 	)
 }
 
+func generateNetworkConfigWithDefault(mockTestEnv mock.MockTestEnv, isDefault bool) string {
+	return fmt.Sprintf(
+		`
+%s
+
+resource "warren_network" "test" {
+	is_default = %t
+	name = "test"
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		isDefault,
+	)
+}
+
 func NetworkTests(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
 	var mockTestEnv mock.MockTestEnv
 	t := GinkgoT()
@@ -74,6 +98,29 @@ func NetworkTests(providerFactories map[string]func() (tfprotov6.ProviderServer,
 			)
 		})
 
+		It("is correctly imported by name", func() {
+			mock.SetupNetworkEndpointOnMux(mockTestEnv.Mux, false)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// ImportState testing
+						{
+							Config:        generateNetworkConfig(mockTestEnv, "test"),
+							ResourceName:  "warren_network.test",
+							ImportState:   true,
+							ImportStateId: "name:test",
+							Check:         resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_network.test", "id", mock.TestNetworkUUID),
+							),
+						},
+					},
+				},
+			)
+		})
+
 		It("is correctly handled", func() {
 			mock.SetupNetworkEndpointOnMux(mockTestEnv.Mux, true)
 
@@ -95,6 +142,166 @@ func NetworkTests(providerFactories map[string]func() (tfprotov6.ProviderServer,
 			)
 		})
 
+		It("is correctly removed after being deleted outside of Terraform", func() {
+			mock.SetupNetworkEndpointOnMux(mockTestEnv.Mux, true)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateNetworkConfig(mockTestEnv, "test"),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_network.test", "id", mock.TestNetworkUUID),
+							),
+						},
+						// Deleted outside of Terraform testing
+						{
+							PreConfig: func() {
+								err := mockTestEnv.Client.Network.DeleteNetworkByUUID(mock.TestNetworkUUID)
+								Expect(err).ToNot(HaveOccurred())
+							},
+							Config:             generateNetworkConfig(mockTestEnv, "test"),
+							PlanOnly:           true,
+							ExpectNonEmptyPlan: true,
+						},
+						// Recreate testing
+						{
+							Config: generateNetworkConfig(mockTestEnv, "test"),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_network.test", "id", mock.TestNetworkUUID),
+							),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		It("is correctly set as default", func() {
+			mock.SetupNetworkEndpointOnMux(mockTestEnv.Mux, true)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateNetworkConfig(mockTestEnv, "test"),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_network.test", "is_default", "false"),
+							),
+						},
+						// Set as default testing
+						{
+							Config:           generateNetworkConfigWithDefault(mockTestEnv, true),
+							ConfigPlanChecks: resource.ConfigPlanChecks{
+								PreApply: []plancheck.PlanCheck{
+									plancheck.ExpectResourceAction("warren_network.test", plancheck.ResourceActionUpdate),
+								},
+							},
+							Check:            resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_network.test", "id", mock.TestNetworkUUID),
+								resource.TestCheckResourceAttr("warren_network.test", "is_default", "true"),
+							),
+						},
+						// Unset as default testing
+						{
+							Config:      generateNetworkConfigWithDefault(mockTestEnv, false),
+							ExpectError: regexp.MustCompile("The default network can not be unset"),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		It("is correctly created as default", func() {
+			mock.AddTestDefaultNetwork(mockTestEnv.Mux)
+			mock.SetupNetworkEndpointOnMux(mockTestEnv.Mux, true)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create as default and Read testing
+						{
+							Config: generateNetworkConfigWithDefault(mockTestEnv, true),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_network.test", "id", mock.TestNetworkUUID),
+								resource.TestCheckResourceAttr("warren_network.test", "is_default", "true"),
+								func(_ *terraform.State) error {
+									networks, err := mockTestEnv.Client.Network.ListNetworks()
+									Expect(err).ToNot(HaveOccurred())
+
+									for _, network := range *networks {
+										Expect(network.IsDefault).To(Equal(mock.TestNetworkUUID == network.Uuid))
+									}
+
+									return nil
+								},
+							),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		It("warns if another network loses its default flag", func() {
+			var schemaResp frameworkResource.SchemaResponse
+
+			mock.AddTestDefaultNetwork(mockTestEnv.Mux)
+			mock.SetupNetworkEndpointOnMux(mockTestEnv.Mux, true)
+
+			ctx := context.Background()
+			r := NewNetwork().(*Network)
+			r.Configure(ctx, frameworkResource.ConfigureRequest{ ProviderData: mockTestEnv.Client }, &frameworkResource.ConfigureResponse{})
+			r.Schema(ctx, frameworkResource.SchemaRequest{}, &schemaResp)
+
+			schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			plan := tfsdk.Plan{
+				Raw:    tftypes.NewValue(schemaType, nil),
+				Schema: schemaResp.Schema,
+			}
+
+			Expect(plan.Set(ctx, &warren.NetworkModel{
+				CreatedAt:   types.StringUnknown(),
+				IsDefault:   types.BoolValue(true),
+				Name:        types.StringValue("test"),
+				ServerUUIDs: types.ListUnknown(types.StringType),
+				SubnetIPv4:  types.StringUnknown(),
+				SubnetIPv6:  types.StringUnknown(),
+				Type:        types.StringUnknown(),
+				UpdatedAt:   types.StringUnknown(),
+				UUID:        types.StringUnknown(),
+				VLANID:      types.Int64Unknown(),
+			})).To(BeEmpty())
+
+			modifyPlanResp := frameworkResource.ModifyPlanResponse{ Plan: plan }
+
+			r.ModifyPlan(
+				ctx,
+				frameworkResource.ModifyPlanRequest{
+					Plan:  plan,
+					State: tfsdk.State{ Raw: tftypes.NewValue(schemaType, nil), Schema: schemaResp.Schema },
+				},
+				&modifyPlanResp,
+			)
+
+			Expect(modifyPlanResp.Diagnostics.HasError()).To(BeFalse())
+
+			warnings := modifyPlanResp.Diagnostics.Warnings()
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].Summary()).To(Equal("Default network will change"))
+			Expect(warnings[0].Detail()).To(ContainSubstring(mock.TestDefaultNetworkUUID))
+		})
+
 		Expect(t.Failed()).To(BeFalse())
 	})
 }
@@ -10,7 +10,8 @@ obtain one at http://mozilla.org/MPL/2.0/.
 package resources
 
 import (
-    "github.com/hashicorp/terraform-plugin-framework/attr"
+	"time"
+
     "github.com/hashicorp/terraform-plugin-framework/types"
 	"gitlab.com/warrenio/library/go-client/warren"
 )
@@ -29,7 +30,10 @@ type diskCreateMethodData struct {
 // DiskModel describes the resource model for a disk
 type DiskModel struct {
 	BillingAccount        types.Int64  `tfsdk:"billing_account"`
+	CloneFromDiskUUID     types.String `tfsdk:"clone_from_disk_uuid"`
 	CreatedAt             types.String `tfsdk:"created_at"`
+	ExternalImageURL      types.String `tfsdk:"external_image_url"`
+	ForceDetach           types.Bool   `tfsdk:"force_detach"`
 	ServerUUID            types.String `tfsdk:"server_uuid"`
 	SizeInGB              types.Int64  `tfsdk:"size_in_gb"`
 	Snapshots             types.List   `tfsdk:"snapshots"`
@@ -42,13 +46,38 @@ type DiskModel struct {
 	UUID                  types.String `tfsdk:"id"`
 }
 
+// DiskAttachment defines the resource implementation.
+type DiskAttachment struct {
+	client *warren.Client
+}
+
+// DiskAttachmentModel describes the resource model for a disk attachment
+type DiskAttachmentModel struct {
+	DiskUUID   types.String `tfsdk:"disk_uuid"`
+	ID         types.String `tfsdk:"id"`
+	ServerUUID types.String `tfsdk:"server_uuid"`
+}
+
+// DiskSnapshot defines the resource implementation.
+type DiskSnapshot struct {
+	client *warren.Client
+}
+
+// DiskSnapshotModel describes the resource model for a disk snapshot
+type DiskSnapshotModel struct {
+	CreatedAt types.String `tfsdk:"created_at"`
+	DiskUUID  types.String `tfsdk:"disk_uuid"`
+	SizeInGB  types.Int64  `tfsdk:"size_in_gb"`
+	UUID      types.String `tfsdk:"id"`
+}
+
 // FloatingIP defines the resource implementation.
 type FloatingIP struct {
 	client *warren.Client
 }
 
 type floatingIPCreateMethodData struct {
-	FloatingIPID int
+	FloatingIPUUID string
 }
 
 // FloatingIPModel describes the resource model for a floating IP
@@ -70,6 +99,25 @@ type FloatingIPModel struct {
 	UUID                   types.String `tfsdk:"uuid"`
 }
 
+// FloatingIPModelV0 describes the resource model for a floating IP of schema version 0
+type FloatingIPModelV0 struct {
+	AssignedTo             types.String `tfsdk:"assigned_to"`
+	AssignedToPrivateIP    types.String `tfsdk:"assigned_to_private_ip"`
+	AssignedToResourceType types.String `tfsdk:"assigned_to_resource_type"`
+	Address                types.String `tfsdk:"address"`
+	BillingAccount         types.Int64  `tfsdk:"billing_account"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	ID                     types.String `tfsdk:"id"`
+	IsIPv6                 types.Bool   `tfsdk:"is_ipv6"`
+	Name                   types.String `tfsdk:"name"`
+	NetworkUUID            types.String `tfsdk:"network_uuid"`
+	Type                   types.String `tfsdk:"type"`
+	UpdatedAt              types.String `tfsdk:"updated_at"`
+	UserID                 types.Int64  `tfsdk:"user_id"`
+	UUID                   types.String `tfsdk:"uuid"`
+}
+
 // Network defines the resource implementation.
 type Network struct {
 	client *warren.Client
@@ -116,29 +164,9 @@ const (
 	warrenPasswordGeneratedLength = 32
 	warrenDefaultVMUsername = "user"
 	NetworkAssignedToResourceVM = "virtual_machine"
-)
-
-var (
-	DiskSnapshotType = map[string]attr.Type{
-		"created_at": types.StringType,
-		"disk_uuid":  types.StringType,
-		"size_in_gb": types.Int64Type,
-		"uuid":       types.StringType,
-	}
-	VirtualMachineStorageType = map[string]attr.Type{
-		"created_at": types.StringType,
-		"name":       types.StringType,
-		"primary":    types.BoolType,
-		"replica":    types.ListType{ ElemType: types.ObjectType{ AttrTypes: VirtualMachineStorageReplicaType } },
-		"size_in_gb": types.Int64Type,
-		"user_id":    types.Int64Type,
-		"uuid":       types.StringType,
-	}
-	VirtualMachineStorageReplicaType = map[string]attr.Type{
-		"created_at":  types.StringType,
-		"master_uuid": types.StringType,
-		"size_in_gb":  types.Int64Type,
-		"type":        types.StringType,
-		"uuid":        types.StringType,
-	}
+	diskCopyTimeout = 60 * time.Minute
+	diskCreateTimeout = 10 * time.Minute
+	diskDetachTimeout = 5 * time.Minute
+	diskDeleteTimeout = 5 * time.Minute
+	diskResizeTimeout = 10 * time.Minute
 )
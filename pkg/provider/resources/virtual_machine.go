@@ -18,7 +18,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"gitlab.com/warrenio/library/go-client/warren"
+	warrenTypes "gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
 )
 
@@ -181,13 +181,23 @@ func (r *VirtualMachine) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *VirtualMachine) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	server, err := r.client.VirtualMachine.GetByUuid(req.ID)
+	importID, err := parseImportID(ctx, r.client, req.ID)
 	if nil != err {
-		resp.Diagnostics.AddError("Virtual machine import error", apis.GetServerErrorFromHttpCallError(err).Error())
+		resp.Diagnostics.AddError("Virtual machine import error", err.Error())
+		return
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-	if resp.Diagnostics.HasError() {
+	var server *warren.VirtualMachine
+
+	if "" != importID.Name {
+		server, err = apis.GetServerByName(importID.Client, importID.Name)
+	} else {
+		server, err = importID.Client.VirtualMachine.GetByUuid(importID.UUID)
+		err = apis.GetServerErrorFromHttpCallError(err)
+	}
+
+	if nil != err {
+		resp.Diagnostics.AddError("Virtual machine import error", err.Error())
 		return
 	}
 
@@ -202,6 +212,54 @@ func (r *VirtualMachine) Metadata(ctx context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_virtual_machine"
 }
 
+func (r *VirtualMachine) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		// Nothing to do for resource instance creation or destruction
+		return
+	}
+
+	var (
+		planData  VirtualMachineModel
+		stateData VirtualMachineModel
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planData.NetworkUUID.IsUnknown() && !stateData.NetworkUUID.Equal(planData.NetworkUUID) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("network_uuid"),
+			"Virtual machine plan error",
+			"Changing the network of an existing machine is currently not implemented",
+		)
+	}
+
+	if planData.DiskSizeInGB.IsUnknown() || stateData.DiskSizeInGB.IsNull() {
+		return
+	}
+
+	if planData.DiskSizeInGB.ValueInt64() < stateData.DiskSizeInGB.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("disk_size_in_gb"),
+			"Virtual machine plan error",
+			fmt.Sprintf(
+				"Virtual machine boot disk can not be shrunk from %d GB to %d GB",
+				stateData.DiskSizeInGB.ValueInt64(),
+				planData.DiskSizeInGB.ValueInt64(),
+			),
+		)
+	}
+}
+
 func (r *VirtualMachine) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data VirtualMachineModel
 
@@ -251,52 +309,13 @@ func (r *VirtualMachine) setStateData(ctx context.Context, server *warren.Virtua
 	data.UUID = types.StringValue(server.Uuid)
 	data.VCPU = types.Int64Value(int64(server.VCpu))
 
-	storage := []attr.Value{}
-
 	for _, serverStorage := range server.Storage {
-		if serverStorage.Primary && data.DiskSizeInGB.IsNull() {
+		if serverStorage.Primary {
 			data.DiskSizeInGB = types.Int64Value(int64(serverStorage.Size))
 		}
-
-		storageReplica := []attr.Value{}
-
-		for _, serverStorageReplica := range serverStorage.Replica {
-			storageReplica = append(
-				storageReplica,
-				types.ObjectValueMust(
-					VirtualMachineStorageReplicaType,
-					map[string]attr.Value{
-						"created_at":  types.StringValue(serverStorageReplica.CreatedAt),
-						"master_uuid": types.StringValue(serverStorageReplica.MasterUuid),
-						"size_in_gb":  types.Int64Value(int64(serverStorageReplica.Size)),
-						"type":        types.StringValue(serverStorageReplica.Type),
-						"uuid":        types.StringValue(serverStorageReplica.Uuid),
-					},
-				),
-			)
-		}
-
-		storage = append(
-			storage,
-			types.ObjectValueMust(
-				VirtualMachineStorageType,
-				map[string]attr.Value{
-					"created_at": types.StringValue(serverStorage.CreatedAt),
-					"name":       types.StringValue(serverStorage.Name),
-					"primary":    types.BoolValue(serverStorage.Primary),
-					"replica": types.ListValueMust(
-						types.ObjectType{AttrTypes: VirtualMachineStorageReplicaType},
-						storageReplica,
-					),
-					"size_in_gb": types.Int64Value(int64(serverStorage.Size)),
-					"user_id":    types.Int64Value(int64(serverStorage.UserId)),
-					"uuid":       types.StringValue(serverStorage.Uuid),
-				},
-			),
-		)
 	}
 
-	data.Storage = types.ListValueMust(types.ObjectType{AttrTypes: VirtualMachineStorageType}, storage)
+	data.Storage = warrenTypes.VirtualMachineStorageListValue(server.Storage)
 
 	network, _ := apis.GetNetworkFromServerUUID(r.client, server.Uuid)
 	if nil != network {
@@ -317,7 +336,8 @@ func (r *VirtualMachine) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"billing_account": schema.Int64Attribute{
@@ -325,7 +345,8 @@ func (r *VirtualMachine) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"cloud_init": schema.StringAttribute{
@@ -344,11 +365,8 @@ func (r *VirtualMachine) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 			},
 			"disk_size_in_gb": schema.Int64Attribute{
-				MarkdownDescription: "Virtual machine boot disk size in GB",
+				MarkdownDescription: "Virtual machine boot disk size in GB. The boot disk is grown in place and can not be shrunk.",
 				Required:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
 			},
 			"hostname": schema.StringAttribute{
 				MarkdownDescription: "Virtual machine hostname",
@@ -519,8 +537,8 @@ func (r *VirtualMachine) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"vcpu": schema.Int64Attribute{
@@ -536,5 +554,72 @@ func (r *VirtualMachine) Schema(ctx context.Context, req resource.SchemaRequest,
 }
 
 func (r *VirtualMachine) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Virtual machine update error", "Updating an existing machine is currently not implemented")
+	var (
+		oldData VirtualMachineModel
+		newData VirtualMachineModel
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &newData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &oldData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverUUID := oldData.UUID.ValueString()
+
+	server, err := r.client.VirtualMachine.GetByUuid(serverUUID)
+	if nil != err {
+		resp.Diagnostics.AddError("Virtual machine update error", apis.GetServerErrorFromHttpCallError(err).Error())
+		return
+	}
+
+	if !oldData.DiskSizeInGB.Equal(newData.DiskSizeInGB) {
+		var bootDiskUUID string
+
+		for _, serverStorage := range server.Storage {
+			if serverStorage.Primary {
+				bootDiskUUID = serverStorage.Uuid
+			}
+		}
+
+		if "" == bootDiskUUID {
+			resp.Diagnostics.AddError("Virtual machine update error", fmt.Sprintf("Virtual machine %s has no boot disk", serverUUID))
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("Virtual machine boot disk %s will be resized to %d GB", bootDiskUUID, newData.DiskSizeInGB.ValueInt64()))
+
+		_, err := apis.ResizeVolume(r.client, bootDiskUUID, int(newData.DiskSizeInGB.ValueInt64()))
+		if nil != err {
+			resp.Diagnostics.AddError("Virtual machine update error", err.Error())
+			return
+		}
+
+		_, err = apis.WaitForVolumeResized(ctx, r.client, bootDiskUUID, int(newData.DiskSizeInGB.ValueInt64()), diskResizeTimeout)
+		if nil != err {
+			resp.Diagnostics.AddError("Virtual machine update error", err.Error())
+			return
+		}
+
+		server, err = r.client.VirtualMachine.GetByUuid(serverUUID)
+		if nil != err {
+			resp.Diagnostics.AddError("Virtual machine update error", apis.GetServerErrorFromHttpCallError(err).Error())
+			return
+		}
+	}
+
+	err = r.setStateData(ctx, server, &newData)
+	if nil != err {
+		resp.Diagnostics.AddError("Virtual machine update error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newData)...)
 }
@@ -10,10 +10,14 @@ obtain one at http://mozilla.org/MPL/2.0/.
 package resources
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
@@ -21,12 +25,16 @@ import (
 )
 
 func generateVirtualMachineConfig(mockTestEnv mock.MockTestEnv, serverName string) string {
+	return generateVirtualMachineConfigWithDiskSize(mockTestEnv, serverName, mock.TestDiskSizeInGB)
+}
+
+func generateVirtualMachineConfigWithDiskSize(mockTestEnv mock.MockTestEnv, serverName string, diskSizeInGB int) string {
 	return fmt.Sprintf(
 		`
 %s
 
 resource "warren_virtual_machine" "test" {
-	disk_size_in_gb = 20
+	disk_size_in_gb = %d
 	memory = 2048
 	name = %q
 	username = "example"
@@ -36,10 +44,34 @@ resource "warren_virtual_machine" "test" {
 }
 		`,
 		mockTestEnv.ProviderConfig,
+		diskSizeInGB,
 		serverName,
 	)
 }
 
+func generateVirtualMachineConfigWithNetwork(mockTestEnv mock.MockTestEnv, serverName string, networkUUID string) string {
+	return fmt.Sprintf(
+		`
+%s
+
+resource "warren_virtual_machine" "test" {
+	disk_size_in_gb = %d
+	memory = 2048
+	name = %q
+	network_uuid = %q
+	username = "example"
+	os_name = "ubuntu"
+	os_version = "16.04"
+	vcpu = 1
+}
+		`,
+		mockTestEnv.ProviderConfig,
+		mock.TestDiskSizeInGB,
+		serverName,
+		networkUUID,
+	)
+}
+
 func VirtualMachineTests(providerFactories map[string]func() (tfprotov6.ProviderServer, error)) {
 	var mockTestEnv mock.MockTestEnv
 	t := GinkgoT()
@@ -81,6 +113,54 @@ func VirtualMachineTests(providerFactories map[string]func() (tfprotov6.Provider
 			)
 		})
 
+		It("is correctly imported from a cassette fixture", func() {
+			cassetteTestEnv := mock.NewCassetteTestEnv("virtual_machine")
+			defer cassetteTestEnv.Teardown()
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// ImportState testing
+						{
+							Config:        generateVirtualMachineConfig(cassetteTestEnv, fmt.Sprintf(mock.TestServerNameTemplate, mock.TestServerUUID)),
+							ResourceName:  "warren_virtual_machine.test",
+							ImportState:   true,
+							ImportStateId: mock.TestServerUUID,
+							Check:         resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_virtual_machine.test", "id", mock.TestServerUUID),
+								resource.TestCheckResourceAttr("warren_virtual_machine.test", "network_uuid", mock.TestNetworkUUID),
+							),
+						},
+					},
+				},
+			)
+		})
+
+		It("is correctly imported by name", func() {
+			mock.SetupVMEndpointOnMux(mockTestEnv.Mux, false)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// ImportState testing
+						{
+							Config:        generateVirtualMachineConfig(mockTestEnv, fmt.Sprintf(mock.TestServerNameTemplate, mock.TestServerUUID)),
+							ResourceName:  "warren_virtual_machine.test",
+							ImportState:   true,
+							ImportStateId: fmt.Sprintf("name:%s", fmt.Sprintf(mock.TestServerNameTemplate, mock.TestServerUUID)),
+							Check:         resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_virtual_machine.test", "id", mock.TestServerUUID),
+							),
+						},
+					},
+				},
+			)
+		})
+
 		It("is correctly handled", func() {
 			mock.SetupVMEndpointOnMux(mockTestEnv.Mux, true)
 
@@ -102,6 +182,89 @@ func VirtualMachineTests(providerFactories map[string]func() (tfprotov6.Provider
 			)
 		})
 
+		It("is correctly resized", func() {
+			mock.SetupDiskEndpointOnMux(mockTestEnv.Mux, false)
+			mock.SetupVMEndpointOnMux(mockTestEnv.Mux, true)
+
+			serverName := fmt.Sprintf(mock.TestServerNameTemplate, mock.TestServerUUID)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateVirtualMachineConfig(mockTestEnv, serverName),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_virtual_machine.test", "disk_size_in_gb", fmt.Sprint(mock.TestDiskSizeInGB)),
+							),
+						},
+						// Grow in place testing
+						{
+							Config:           generateVirtualMachineConfigWithDiskSize(mockTestEnv, serverName, 30),
+							ConfigPlanChecks: resource.ConfigPlanChecks{
+								PreApply: []plancheck.PlanCheck{
+									plancheck.ExpectResourceAction("warren_virtual_machine.test", plancheck.ResourceActionUpdate),
+								},
+							},
+							Check:            resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_virtual_machine.test", "disk_size_in_gb", "30"),
+								resource.TestCheckResourceAttr("warren_virtual_machine.test", "storage.0.size_in_gb", "30"),
+							),
+						},
+						// Resized outside of Terraform testing
+						{
+							PreConfig: func() {
+								_, err := apis.ResizeVolume(mockTestEnv.Client, mock.TestDiskUUID, 40)
+								Expect(err).ToNot(HaveOccurred())
+
+								_, err = apis.WaitForVolumeResized(context.Background(), mockTestEnv.Client, mock.TestDiskUUID, 40, time.Minute)
+								Expect(err).ToNot(HaveOccurred())
+							},
+							Config:    generateVirtualMachineConfigWithDiskSize(mockTestEnv, serverName, 40),
+							PlanOnly:  true,
+						},
+						// Shrink testing
+						{
+							Config:      generateVirtualMachineConfigWithDiskSize(mockTestEnv, serverName, 10),
+							ExpectError: regexp.MustCompile("(?s)boot disk can not be shrunk.*from 40 GB to 10 GB"),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
+		It("is not moved to another network", func() {
+			mock.SetupVMEndpointOnMux(mockTestEnv.Mux, true)
+
+			serverName := fmt.Sprintf(mock.TestServerNameTemplate, mock.TestServerUUID)
+
+			resource.UnitTest(
+				t,
+				resource.TestCase{
+					ProtoV6ProviderFactories: providerFactories,
+					Steps: []resource.TestStep{
+						// Create and Read testing
+						{
+							Config: generateVirtualMachineConfig(mockTestEnv, serverName),
+							Check:  resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("warren_virtual_machine.test", "network_uuid", mock.TestNetworkUUID),
+							),
+						},
+						// Network change testing
+						{
+							Config:      generateVirtualMachineConfigWithNetwork(mockTestEnv, serverName, "3456789a-bcde-4f01-2345-6789abcdef01"),
+							PlanOnly:    true,
+							ExpectError: regexp.MustCompile("Changing the network of an existing machine is currently not implemented"),
+						},
+						// Delete testing automatically occurs in TestCase
+					},
+				},
+			)
+		})
+
 		Expect(t.Failed()).To(BeFalse())
 	})
 }
@@ -16,7 +16,10 @@ import (
 
 var _ = Describe("Resources", func() {
 	resources.DiskTests(testProviderV6Factories)
+	resources.DiskAttachmentTests(testProviderV6Factories)
+	resources.DiskSnapshotTests(testProviderV6Factories)
 	resources.FloatingIPTests(testProviderV6Factories)
+	resources.ImportTests()
 	resources.NetworkTests(testProviderV6Factories)
 	resources.VirtualMachineTests(testProviderV6Factories)
 })
@@ -0,0 +1,377 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package provider is the main Terraform provider code package
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// schemaSnapshotAttribute is the golden file representation of an attribute
+type schemaSnapshotAttribute struct {
+	Computed        bool   `json:"computed"`
+	Optional        bool   `json:"optional"`
+	Required        bool   `json:"required"`
+	RequiresReplace bool   `json:"requires_replace"`
+	Sensitive       bool   `json:"sensitive"`
+	Type            string `json:"type"`
+}
+
+// schemaSnapshot is the golden file representation of a schema
+type schemaSnapshot struct {
+	Attributes map[string]schemaSnapshotAttribute `json:"attributes"`
+	Version    int64                              `json:"version"`
+}
+
+// schemaSnapshotAttributeInterface is implemented by all schema attributes
+type schemaSnapshotAttributeInterface interface {
+	GetType() attr.Type
+	IsComputed() bool
+	IsOptional() bool
+	IsRequired() bool
+	IsSensitive() bool
+}
+
+// schemaSnapshotPlanModifier is implemented by all plan modifiers
+type schemaSnapshotPlanModifier interface {
+	Description(ctx context.Context) string
+}
+
+const (
+	schemaSnapshotDir = "testdata/schemas"
+	schemaSnapshotAcknowledgedFile = "testdata/schemas/acknowledged_breaking_changes.txt"
+	schemaSnapshotUpdateEnv = "WARREN_UPDATE_SCHEMA_SNAPSHOTS"
+)
+
+// addSchemaSnapshotAttributes adds the given attributes and nested ones
+// recursively to the schema snapshot.
+//
+// PARAMETERS
+// ctx        context.Context        Execution context
+// snapshot   *schemaSnapshot        Schema snapshot
+// prefix     string                 Attribute path prefix
+// attributes map[string]interface{} Attributes to add
+func addSchemaSnapshotAttributes(ctx context.Context, snapshot *schemaSnapshot, prefix string, attributes map[string]interface{}) {
+	for name, attribute := range attributes {
+		attributePath := prefix + name
+		schemaAttribute := attribute.(schemaSnapshotAttributeInterface)
+
+		snapshot.Attributes[attributePath] = schemaSnapshotAttribute{
+			Computed:        schemaAttribute.IsComputed(),
+			Optional:        schemaAttribute.IsOptional(),
+			Required:        schemaAttribute.IsRequired(),
+			RequiresReplace: isSchemaSnapshotAttributeRequiringReplace(ctx, attribute),
+			Sensitive:       schemaAttribute.IsSensitive(),
+			Type:            schemaAttribute.GetType().String(),
+		}
+
+		nestedAttributes := make(map[string]interface{})
+
+		switch typedAttribute := attribute.(type) {
+		case datasourceSchema.ListNestedAttribute:
+			for nestedName, nestedAttribute := range typedAttribute.NestedObject.Attributes {
+				nestedAttributes[nestedName] = nestedAttribute
+			}
+		case datasourceSchema.SingleNestedAttribute:
+			for nestedName, nestedAttribute := range typedAttribute.Attributes {
+				nestedAttributes[nestedName] = nestedAttribute
+			}
+		case resourceSchema.ListNestedAttribute:
+			for nestedName, nestedAttribute := range typedAttribute.NestedObject.Attributes {
+				nestedAttributes[nestedName] = nestedAttribute
+			}
+		case resourceSchema.SingleNestedAttribute:
+			for nestedName, nestedAttribute := range typedAttribute.Attributes {
+				nestedAttributes[nestedName] = nestedAttribute
+			}
+		}
+
+		addSchemaSnapshotAttributes(ctx, snapshot, attributePath + ".", nestedAttributes)
+	}
+}
+
+// isSchemaSnapshotAttributeRequiringReplace returns true if a plan modifier
+// of the given attribute may force the resource to be replaced.
+//
+// PARAMETERS
+// ctx       context.Context Execution context
+// attribute interface{}     Schema attribute
+func isSchemaSnapshotAttributeRequiringReplace(ctx context.Context, attribute interface{}) bool {
+	var planModifiers []schemaSnapshotPlanModifier
+
+	switch typedAttribute := attribute.(type) {
+	case interface{ BoolPlanModifiers() []planmodifier.Bool }:
+		for _, planModifier := range typedAttribute.BoolPlanModifiers() {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case interface{ Int64PlanModifiers() []planmodifier.Int64 }:
+		for _, planModifier := range typedAttribute.Int64PlanModifiers() {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case interface{ ListPlanModifiers() []planmodifier.List }:
+		for _, planModifier := range typedAttribute.ListPlanModifiers() {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+		for _, planModifier := range typedAttribute.ObjectPlanModifiers() {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case interface{ StringPlanModifiers() []planmodifier.String }:
+		for _, planModifier := range typedAttribute.StringPlanModifiers() {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	}
+
+	for _, planModifier := range planModifiers {
+		// Descriptions of all "RequiresReplace" plan modifiers mention it
+		if strings.Contains(planModifier.Description(ctx), "destroy and recreate the resource") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getSchemaSnapshotBreakingChanges returns all changes between the golden
+// and the current schema snapshot breaking existing configurations or state.
+//
+// PARAMETERS
+// typeName string         Resource or data source type name
+// golden   schemaSnapshot Committed schema snapshot
+// current  schemaSnapshot Current schema snapshot
+func getSchemaSnapshotBreakingChanges(typeName string, golden, current schemaSnapshot) []string {
+	breakingChanges := []string{}
+
+	for attributePath, goldenAttribute := range golden.Attributes {
+		currentAttribute, ok := current.Attributes[attributePath]
+
+		if !ok {
+			breakingChanges = append(breakingChanges, fmt.Sprintf("%s: removed attribute: %s", typeName, attributePath))
+			continue
+		}
+
+		if !goldenAttribute.Required && currentAttribute.Required {
+			breakingChanges = append(breakingChanges, fmt.Sprintf("%s: optional attribute became required: %s", typeName, attributePath))
+		}
+
+		if goldenAttribute.Type != currentAttribute.Type {
+			breakingChanges = append(breakingChanges, fmt.Sprintf("%s: changed attribute type: %s", typeName, attributePath))
+		}
+
+		if !goldenAttribute.RequiresReplace && currentAttribute.RequiresReplace {
+			breakingChanges = append(breakingChanges, fmt.Sprintf("%s: added requires replace: %s", typeName, attributePath))
+		}
+	}
+
+	// State of a changed schema version is migrated and may change meaning
+	if golden.Version != current.Version {
+		breakingChanges = append(breakingChanges, fmt.Sprintf("%s: changed schema version: %d -> %d", typeName, golden.Version, current.Version))
+	}
+
+	for attributePath, currentAttribute := range current.Attributes {
+		if _, ok := golden.Attributes[attributePath]; !ok && currentAttribute.Required {
+			breakingChanges = append(breakingChanges, fmt.Sprintf("%s: added required attribute: %s", typeName, attributePath))
+		}
+	}
+
+	sort.Strings(breakingChanges)
+
+	return breakingChanges
+}
+
+// getSchemaSnapshots returns the current schema snapshots of all resources and
+// data sources keyed by the golden file path.
+//
+// PARAMETERS
+// ctx context.Context Execution context
+func getSchemaSnapshots(ctx context.Context) map[string]schemaSnapshot {
+	p := &WarrenProvider{}
+	snapshots := make(map[string]schemaSnapshot)
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		var (
+			metadataResp datasource.MetadataResponse
+			schemaResp   datasource.SchemaResponse
+		)
+
+		dataSource := newDataSource()
+		dataSource.Metadata(ctx, datasource.MetadataRequest{ ProviderTypeName: "warren" }, &metadataResp)
+		dataSource.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+		snapshot := schemaSnapshot{ Attributes: make(map[string]schemaSnapshotAttribute) }
+		attributes := make(map[string]interface{})
+
+		for name, attribute := range schemaResp.Schema.Attributes {
+			attributes[name] = attribute
+		}
+
+		addSchemaSnapshotAttributes(ctx, &snapshot, "", attributes)
+		snapshots[filepath.Join(schemaSnapshotDir, "data-sources", metadataResp.TypeName + ".json")] = snapshot
+	}
+
+	for _, newResource := range p.Resources(ctx) {
+		var (
+			metadataResp resource.MetadataResponse
+			schemaResp   resource.SchemaResponse
+		)
+
+		r := newResource()
+		r.Metadata(ctx, resource.MetadataRequest{ ProviderTypeName: "warren" }, &metadataResp)
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		snapshot := schemaSnapshot{
+			Attributes: make(map[string]schemaSnapshotAttribute),
+			Version:    schemaResp.Schema.Version,
+		}
+
+		attributes := make(map[string]interface{})
+
+		for name, attribute := range schemaResp.Schema.Attributes {
+			attributes[name] = attribute
+		}
+
+		addSchemaSnapshotAttributes(ctx, &snapshot, "", attributes)
+		snapshots[filepath.Join(schemaSnapshotDir, "resources", metadataResp.TypeName + ".json")] = snapshot
+	}
+
+	return snapshots
+}
+
+// isStringInList returns true if the given string is contained in the list.
+//
+// PARAMETERS
+// value string   String to look up
+// list  []string List of strings
+func isStringInList(value string, list []string) bool {
+	for _, entry := range list {
+		if entry == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+var _ = Describe("Schema", func() {
+	ctx := context.Background()
+
+	It("classifies breaking changes", func() {
+		golden := schemaSnapshot{
+			Attributes: map[string]schemaSnapshotAttribute{
+				"name":   { Optional: true },
+				"size":   { Required: true },
+				"status": { Computed: true },
+				"uuid":   { Computed: true, Type: "basetypes.Int64Type" },
+			},
+		}
+
+		current := schemaSnapshot{
+			Attributes: map[string]schemaSnapshotAttribute{
+				"name":        { Required: true },
+				"size":        { Required: true, RequiresReplace: true },
+				"description": { Optional: true },
+				"uuid":        { Computed: true, Type: "basetypes.StringType" },
+			},
+			Version: 1,
+		}
+
+		Expect(getSchemaSnapshotBreakingChanges("warren_test", golden, current)).To(Equal([]string{
+			"warren_test: added requires replace: size",
+			"warren_test: changed attribute type: uuid",
+			"warren_test: changed schema version: 0 -> 1",
+			"warren_test: optional attribute became required: name",
+			"warren_test: removed attribute: status",
+		}))
+	})
+
+	It("matches the committed snapshots", func() {
+		var acknowledgedBreakingChanges []string
+
+		acknowledgedData, err := os.ReadFile(schemaSnapshotAcknowledgedFile)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, line := range strings.Split(string(acknowledgedData), "\n") {
+			line = strings.TrimSpace(line)
+
+			if "" != line && !strings.HasPrefix(line, "#") {
+				acknowledgedBreakingChanges = append(acknowledgedBreakingChanges, line)
+			}
+		}
+
+		isUpdateRequested := "" != os.Getenv(schemaSnapshotUpdateEnv)
+		currentSnapshots := getSchemaSnapshots(ctx)
+		goldenSnapshotsData := make(map[string][]byte)
+		unacknowledgedBreakingChanges := []string{}
+
+		for snapshotPath, current := range currentSnapshots {
+			golden := schemaSnapshot{ Attributes: make(map[string]schemaSnapshotAttribute) }
+			typeName := strings.TrimSuffix(filepath.Base(snapshotPath), ".json")
+
+			goldenData, err := os.ReadFile(snapshotPath)
+			if nil == err {
+				Expect(json.Unmarshal(goldenData, &golden)).To(Succeed())
+			} else if !os.IsNotExist(err) {
+				Fail(err.Error())
+			}
+
+			goldenSnapshotsData[snapshotPath] = goldenData
+
+			// New resources and data sources can not break existing configurations
+			if nil != goldenData {
+				for _, breakingChange := range getSchemaSnapshotBreakingChanges(typeName, golden, current) {
+					if !isStringInList(breakingChange, acknowledgedBreakingChanges) {
+						unacknowledgedBreakingChanges = append(unacknowledgedBreakingChanges, breakingChange)
+					}
+				}
+			}
+		}
+
+		// Snapshots are never updated with breaking changes not acknowledged
+		sort.Strings(unacknowledgedBreakingChanges)
+
+		Expect(unacknowledgedBreakingChanges).To(
+			BeEmpty(),
+			fmt.Sprintf("Breaking schema changes must be added to %s", schemaSnapshotAcknowledgedFile),
+		)
+
+		for snapshotPath, current := range currentSnapshots {
+			typeName := strings.TrimSuffix(filepath.Base(snapshotPath), ".json")
+
+			currentData, err := json.MarshalIndent(current, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			currentData = append(currentData, '\n')
+
+			if isUpdateRequested {
+				Expect(os.MkdirAll(filepath.Dir(snapshotPath), 0755)).To(Succeed())
+				Expect(os.WriteFile(snapshotPath, currentData, 0644)).To(Succeed())
+			} else {
+				Expect(string(goldenSnapshotsData[snapshotPath])).To(
+					Equal(string(currentData)),
+					fmt.Sprintf("Schema of %s changed, run tests with %s=true to update %s", typeName, schemaSnapshotUpdateEnv, snapshotPath),
+				)
+			}
+		}
+	})
+})
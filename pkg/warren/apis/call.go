@@ -0,0 +1,82 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package apis is the main package for Warren specific APIs
+package apis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"gitlab.com/warrenio/library/go-client/warren"
+)
+
+// callAPI executes a raw Warren API call for endpoints not covered by the
+// Warren client. Errors returned are formatted the same way as the ones of
+// the Warren client to be usable with the "Get*ErrorFromHttpCallError"
+// functions.
+//
+// PARAMETERS
+// client       *warren.Client Warren client to take API URL, token and location from
+// method       string         HTTP method
+// path         string         API path relative to the location
+// formParams   url.Values     Form parameters to send or nil
+// responseData any            Value to decode the JSON response into or nil
+func callAPI(client *warren.Client, method, path string, formParams url.Values, responseData any) error {
+	httpClient := getHTTPClient(client.ApiToken)
+
+	slug := ""
+	if "" != client.LocationSlug {
+		slug = "/" + client.LocationSlug
+	}
+
+	fullURL := client.BaseURL.ResolveReference(&url.URL{ Path: fmt.Sprintf("/v1%s%s", slug, path) })
+
+	var body io.Reader
+
+	if nil != formParams {
+		body = strings.NewReader(formParams.Encode())
+	}
+
+	req, err := http.NewRequest(method, fullURL.String(), body)
+	if nil != err {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("apikey", client.ApiToken)
+
+	if nil != formParams {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := httpClient.Do(req)
+	if nil != err {
+		return fmt.Errorf("failed to call HTTP request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("[%d] %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	if nil != responseData {
+		err = json.NewDecoder(resp.Body).Decode(responseData)
+		if nil != err {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ package apis
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
@@ -19,7 +20,10 @@ import (
 	"gitlab.com/warrenio/library/go-client/warren"
 )
 
-var singletons = make(map[string]*warren.Client)
+var (
+	httpClients = make(map[string]*http.Client)
+	singletons  = make(map[string]*warren.Client)
+)
 
 // getClient returns a configured Warren client.
 //
@@ -28,14 +32,14 @@ var singletons = make(map[string]*warren.Client)
 // token    string Warren client token
 // location string Warren client location
 func getClient(ctx context.Context, url, token, location string) *warren.Client {
-	clientBuilder := (&warren.ClientBuilder{}).ApiUrl(url).ApiToken(token)
+	clientBuilder := (&warren.ClientBuilder{}).ApiUrl(url).ApiToken(token).Client(getHTTPClient(token))
 	if location != "" {
 		clientBuilder = clientBuilder.LocationSlug(location)
 	}
 
 	client, err := clientBuilder.Build()
 	if nil != err {
-		tflog.Error(ctx, fmt.Sprintf("Warren platform client initialization failed: %w", err))
+		tflog.Error(ctx, fmt.Sprintf("Warren platform client initialization failed: %s", err))
 	}
 
 	return client
@@ -102,6 +106,21 @@ func GetErrorFromHttpCallError(err error) error {
 	return err
 }
 
+// getHTTPClient returns the HTTP client used for Warren clients of the given
+// token.
+//
+// PARAMETERS
+// token string Token to look up HTTP client instance for
+func getHTTPClient(token string) *http.Client {
+	httpClient := httpClients[token]
+
+	if nil == httpClient {
+		httpClient = http.DefaultClient
+	}
+
+	return httpClient
+}
+
 // GetReconfiguredClientForLocation returns an underlying Warren client for
 // the given location.
 //
@@ -127,3 +146,17 @@ func SetClientForToken(token string, client *warren.Client) {
 		singletons[token] = client
 	}
 }
+
+// SetHTTPClientForToken sets a preconfigured HTTP client used for all Warren
+// clients created afterwards for the given token.
+//
+// PARAMETERS
+// token      string       Token to look up HTTP client instance for
+// httpClient *http.Client Preconfigured HTTP client or nil to use the default one
+func SetHTTPClientForToken(token string, httpClient *http.Client) {
+	if httpClient == nil {
+		delete(httpClients, token)
+	} else {
+		httpClients[token] = httpClient
+	}
+}
@@ -11,6 +11,7 @@ package apis
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
 	"gitlab.com/warrenio/library/go-client/warren"
@@ -35,6 +36,21 @@ func GetFloatingIPErrorFromHttpCallError(err error) error {
 	return err
 }
 
+func GetFloatingIPByAddress(client *warren.Client, address net.IP) (*warren.FloatingIp, error) {
+	floatingIPs, err := client.Network.ListFloatingIps()
+	if nil != err {
+		return nil, GetFloatingIPErrorFromHttpCallError(err)
+	}
+
+	for _, ip := range *floatingIPs {
+		if ip.Enabled && address.Equal(net.ParseIP(ip.Address)) {
+			return &ip, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrFloatingIPNotFound, address)
+}
+
 func GetFloatingIPByID(client *warren.Client, id int) (*warren.FloatingIp, error) {
 	floatingIPs, err := client.Network.ListFloatingIps()
 	if err != nil {
@@ -47,7 +63,22 @@ func GetFloatingIPByID(client *warren.Client, id int) (*warren.FloatingIp, error
 		}
 	}
 
-	return nil, fmt.Errorf("%w: %s", ErrFloatingIPNotFound, id)
+	return nil, fmt.Errorf("%w: %d", ErrFloatingIPNotFound, id)
+}
+
+func GetFloatingIPByUUID(client *warren.Client, uuid string) (*warren.FloatingIp, error) {
+	floatingIPs, err := client.Network.ListFloatingIps()
+	if nil != err {
+		return nil, GetFloatingIPErrorFromHttpCallError(err)
+	}
+
+	for _, ip := range *floatingIPs {
+		if ip.Enabled && ip.Uuid == uuid {
+			return &ip, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrFloatingIPNotFound, uuid)
 }
 
 func GetFloatingIPFromAssignedUUID(client *warren.Client, uuid string) (*warren.FloatingIp, error) {
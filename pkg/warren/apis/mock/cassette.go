@@ -0,0 +1,361 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mock provides all methods required to simulate a Warren Platform environment
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// CassetteMode defines if HTTP interactions are recorded or replayed
+type CassetteMode string
+
+const (
+	CassetteModeRecord CassetteMode = "record"
+	CassetteModeReplay CassetteMode = "replay"
+
+	cassetteRedactedValue = "REDACTED"
+)
+
+// Cassette is the serialized list of recorded HTTP interactions
+type Cassette struct {
+	Interactions []CassetteInteraction `json:"interactions"`
+	LocationSlug string                `json:"location_slug,omitempty"`
+}
+
+// CassetteInteraction is a single recorded HTTP request and its response
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is the sanitized part of a HTTP request used for matching
+type CassetteRequest struct {
+	Body   string `json:"body,omitempty"`
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+}
+
+// CassetteResponse is the sanitized HTTP response served back on replay
+type CassetteResponse struct {
+	Body        string `json:"body,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	StatusCode  int    `json:"status_code"`
+}
+
+// CassetteTransport records HTTP interactions into or replays them from a
+// cassette file.
+type CassetteTransport struct {
+	cassette  Cassette
+	mode      CassetteMode
+	mutex     sync.Mutex
+	path      string
+	transport http.RoundTripper
+	used      []bool
+}
+
+var cassetteSensitiveKeys = []string{"api_token", "apikey", "password", "private_key", "token"}
+
+// NewCassetteTransport returns a new transport for the given mode and
+// cassette file path.
+//
+// PARAMETERS
+// mode      CassetteMode      Record or replay mode
+// path      string            Cassette file path
+// transport http.RoundTripper Transport used to record real API interactions
+func NewCassetteTransport(mode CassetteMode, path string, transport http.RoundTripper) (*CassetteTransport, error) {
+	cassetteTransport := &CassetteTransport{
+		mode:      mode,
+		path:      path,
+		transport: transport,
+	}
+
+	switch mode {
+	case CassetteModeRecord:
+		if nil == cassetteTransport.transport {
+			cassetteTransport.transport = http.DefaultTransport
+		}
+	case CassetteModeReplay:
+		jsonData, err := os.ReadFile(path)
+		if nil != err {
+			return nil, fmt.Errorf("Cassette %s could not be read: %w", path, err)
+		}
+
+		err = json.Unmarshal(jsonData, &cassetteTransport.cassette)
+		if nil != err {
+			return nil, fmt.Errorf("Cassette %s is invalid: %w", path, err)
+		}
+
+		cassetteTransport.used = make([]bool, len(cassetteTransport.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("Unsupported cassette mode: %s", mode)
+	}
+
+	return cassetteTransport, nil
+}
+
+// RoundTrip records or replays the given HTTP request.
+//
+// PARAMETERS
+// req *http.Request HTTP request
+func (t *CassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if nil != req.Body {
+		var err error
+
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if nil != err {
+			return nil, err
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	cassetteReq := CassetteRequest{
+		Body:   sanitizeCassetteBody(string(body), req.Header.Get("Content-Type")),
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.mode == CassetteModeReplay {
+		return t.replay(req, cassetteReq)
+	}
+
+	return t.record(req, cassetteReq)
+}
+
+// record executes the given HTTP request and appends the interaction to the
+// cassette file.
+//
+// PARAMETERS
+// req         *http.Request   HTTP request
+// cassetteReq CassetteRequest Sanitized request
+func (t *CassetteTransport) record(req *http.Request, cassetteReq CassetteRequest) (*http.Response, error) {
+	res, err := t.transport.RoundTrip(req)
+	if nil != err {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+
+	if nil != err {
+		return nil, err
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	contentType := res.Header.Get("Content-Type")
+
+	t.cassette.Interactions = append(
+		t.cassette.Interactions,
+		CassetteInteraction{
+			Request:  cassetteReq,
+			Response: CassetteResponse{
+				Body:        sanitizeCassetteBody(string(body), contentType),
+				ContentType: contentType,
+				StatusCode:  res.StatusCode,
+			},
+		},
+	)
+
+	jsonData, err := json.MarshalIndent(t.cassette, "", "\t")
+	if nil != err {
+		return nil, err
+	}
+
+	err = os.WriteFile(t.path, jsonData, 0644)
+	if nil != err {
+		return nil, fmt.Errorf("Cassette %s could not be written: %w", t.path, err)
+	}
+
+	return res, nil
+}
+
+// replay returns the recorded response matching the given HTTP request.
+//
+// Unused interactions are preferred to support recorded state changes. The
+// last matching interaction is returned again if all of them have been used.
+//
+// PARAMETERS
+// req         *http.Request   HTTP request
+// cassetteReq CassetteRequest Sanitized request
+func (t *CassetteTransport) replay(req *http.Request, cassetteReq CassetteRequest) (*http.Response, error) {
+	matchIndex := -1
+
+	for index, interaction := range t.cassette.Interactions {
+		if interaction.Request != cassetteReq {
+			continue
+		}
+
+		matchIndex = index
+
+		if !t.used[index] {
+			break
+		}
+	}
+
+	if matchIndex < 0 {
+		return nil, fmt.Errorf(
+			"Cassette %s contains no interaction matching request: %s %s?%s %s",
+			t.path,
+			cassetteReq.Method,
+			cassetteReq.Path,
+			cassetteReq.Query,
+			cassetteReq.Body,
+		)
+	}
+
+	t.used[matchIndex] = true
+	cassetteRes := t.cassette.Interactions[matchIndex].Response
+
+	res := &http.Response{
+		Body:          io.NopCloser(strings.NewReader(cassetteRes.Body)),
+		ContentLength: int64(len(cassetteRes.Body)),
+		Header:        make(http.Header),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       req,
+		Status:        fmt.Sprintf("%d %s", cassetteRes.StatusCode, http.StatusText(cassetteRes.StatusCode)),
+		StatusCode:    cassetteRes.StatusCode,
+	}
+
+	if "" != cassetteRes.ContentType {
+		res.Header.Set("Content-Type", cassetteRes.ContentType)
+	}
+
+	return res, nil
+}
+
+// GetUnusedInteractionsError returns an error listing all interactions of
+// the cassette not replayed.
+func (t *CassetteTransport) GetUnusedInteractionsError() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	unusedRequests := []string{}
+
+	for index, isUsed := range t.used {
+		if !isUsed {
+			cassetteReq := t.cassette.Interactions[index].Request
+			unusedRequests = append(unusedRequests, fmt.Sprintf("%s %s?%s", cassetteReq.Method, cassetteReq.Path, cassetteReq.Query))
+		}
+	}
+
+	if len(unusedRequests) > 0 {
+		return fmt.Errorf("Cassette %s contains unused interactions: %s", t.path, strings.Join(unusedRequests, ", "))
+	}
+
+	return nil
+}
+
+// sanitizeCassetteBody redacts sensitive values of JSON or form encoded
+// bodies.
+//
+// PARAMETERS
+// body        string Body to sanitize
+// contentType string Body content type
+func sanitizeCassetteBody(body, contentType string) string {
+	if "" == body {
+		return body
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		formParams, err := url.ParseQuery(body)
+		if nil != err {
+			return body
+		}
+
+		for key := range formParams {
+			if isCassetteSensitiveKey(key) {
+				formParams.Set(key, cassetteRedactedValue)
+			}
+		}
+
+		return formParams.Encode()
+	}
+
+	var jsonData interface{}
+
+	if nil != json.Unmarshal([]byte(body), &jsonData) {
+		return body
+	}
+
+	jsonBody, err := json.Marshal(sanitizeCassetteJSONValue(jsonData))
+	if nil != err {
+		return body
+	}
+
+	return string(jsonBody)
+}
+
+// sanitizeCassetteJSONValue redacts sensitive values of the given decoded
+// JSON value recursively.
+//
+// PARAMETERS
+// value interface{} Decoded JSON value
+func sanitizeCassetteJSONValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key := range typedValue {
+			if isCassetteSensitiveKey(key) {
+				typedValue[key] = cassetteRedactedValue
+			} else {
+				typedValue[key] = sanitizeCassetteJSONValue(typedValue[key])
+			}
+		}
+	case []interface{}:
+		for index := range typedValue {
+			typedValue[index] = sanitizeCassetteJSONValue(typedValue[index])
+		}
+	}
+
+	return value
+}
+
+// isCassetteSensitiveKey returns true if values of the given key must not be
+// recorded.
+//
+// PARAMETERS
+// key string Key to check
+func isCassetteSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, sensitiveKey := range cassetteSensitiveKeys {
+		if key == sensitiveKey {
+			return true
+		}
+	}
+
+	return false
+}
@@ -20,78 +20,399 @@ package mock
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 const (
 	jsonDiskDataTemplate = `
 {
 	"uuid": %q,
-	"status": "Active",
+	"status": %q,
+	"status_comment": %q,
 	"user_id": 8,
 	"billing_account_id": 6,
-	"size_gb": 20,
-	"source_image_type": "EMPTY",
+	"size_gb": %d,
+	"source_image_type": %q,
+	"source_image": %q,
 	"created_at": "2022-09-01T12:03:14.355+0000",
-	"updated_at": "2022-09-01T12:03:14.355+0000"
+	"updated_at": "2022-09-01T12:03:14.355+0000",
+	"snapshots": [%s]
 }
 	`
+	jsonDiskSnapshotDataTemplate = `
+{
+	"uuid": %q,
+	"sizeGb": %d,
+	"created_at": "2022-09-02T08:15:42.123+0000",
+	"disk_uuid": %q
+}
+	`
+	TestDiskExternalImageURL = "https://images.example.com/test-disk.qcow2"
+	TestDiskSizeInGB = 20
+	TestDiskSnapshotUUID = "23456789-abcd-4ef0-1234-56789abcdef1"
 	TestDiskUUID = "12345678-9abc-def0-1234-56789abcdef0"
+	TestSecondDiskUUID = "3456789a-bcde-4f01-2345-6789abcdef12"
 )
 
-// SetupDiskEndpointOnMux configures a "/networks" endpoint on the mux given.
+// testDisk describes a disk simulated by the disk endpoint
+type testDisk struct {
+	ResizedSizeInGB  int
+	SizeInGB         int
+	SnapshotSizeInGB int
+	SnapshotUUID     string
+	SourceImage      string
+	SourceImageType  string
+	Status           string
+	StatusComment    string
+	UUID             string
+}
+
+// testDiskUUIDs contains the UUIDs of all disks that can be simulated in the
+// order they are created
+var testDiskUUIDs = []string{ TestDiskUUID, TestSecondDiskUUID }
+
+// getTestDiskSizeInGB returns the current test disk size for the mux given.
+//
+// PARAMETERS
+// mux *TestMux Mux the disk endpoint is configured on
+func getTestDiskSizeInGB(mux *TestMux) int {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	return mux.diskSizeInGB
+}
+
+// setTestDiskSizeInGB sets the current test disk size for the mux given.
+//
+// PARAMETERS
+// mux      *TestMux Mux the disk endpoint is configured on
+// sizeInGB int      Disk size in GB
+func setTestDiskSizeInGB(mux *TestMux, sizeInGB int) {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	mux.diskSizeInGB = sizeInGB
+}
+
+// FailTestDiskCreation lets the next disk created end up in an error state
+// with the status comment given.
+//
+// PARAMETERS
+// mux           *TestMux Mux the disk endpoint is configured on
+// statusComment string   Status comment of the failed disk
+func FailTestDiskCreation(mux *TestMux, statusComment string) {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	mux.diskCreationFailure = &statusComment
+}
+
+// popTestDiskCreationFailure returns the status comment the next disk created
+// fails with and resets it.
+//
+// PARAMETERS
+// mux *TestMux Mux the disk endpoint is configured on
+func popTestDiskCreationFailure(mux *TestMux) (string, bool) {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	statusComment := mux.diskCreationFailure
+	mux.diskCreationFailure = nil
+
+	if nil == statusComment {
+		return "", false
+	}
+
+	return *statusComment, true
+}
+
+// newJsonDiskData returns the JSON encoded disk data for the disk given.
 //
 // PARAMETERS
-// mux *http.ServeMux Mux to add handler to
-func SetupDiskEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
+// disk *testDisk Disk to encode
+func newJsonDiskData(disk *testDisk) string {
+	snapshotData := ""
+
+	if "" != disk.SnapshotUUID {
+		snapshotData = newJsonDiskSnapshotData(disk)
+	}
+
+	return fmt.Sprintf(
+		jsonDiskDataTemplate,
+		disk.UUID,
+		disk.Status,
+		disk.StatusComment,
+		disk.SizeInGB,
+		disk.SourceImageType,
+		disk.SourceImage,
+		snapshotData,
+	)
+}
+
+// newJsonDiskSnapshotData returns the JSON encoded snapshot data of the disk
+// given.
+//
+// PARAMETERS
+// disk *testDisk Disk the snapshot belongs to
+func newJsonDiskSnapshotData(disk *testDisk) string {
+	return fmt.Sprintf(jsonDiskSnapshotDataTemplate, disk.SnapshotUUID, disk.SnapshotSizeInGB, disk.UUID)
+}
+
+// getDiskSizeInGBFromRequest returns the "size_gb" form parameter of the
+// request given or the default size if it is not set.
+//
+// PARAMETERS
+// req *http.Request HTTP request
+func getDiskSizeInGBFromRequest(req *http.Request) int {
+	err := req.ParseForm()
+	if nil != err {
+		panic(err)
+	}
+
+	sizeInGB, err := strconv.Atoi(req.PostForm.Get("size_gb"))
+	if nil != err {
+		return TestDiskSizeInGB
+	}
+
+	return sizeInGB
+}
+
+// SetupDiskEndpointOnMux configures a "/storage" endpoint on the mux given.
+// Up to two disks are simulated with the test disk being created first. The
+// test disk is the boot disk of the test server as well.
+//
+// PARAMETERS
+// mux *TestMux Mux to add handler to
+func SetupDiskEndpointOnMux(mux *TestMux, emptyUntilCreated bool) {
 	baseURL := "/v1/cyc01/storage"
-	isDiskCreated := !emptyUntilCreated
+	disks := make(map[string]*testDisk)
+	mutex := sync.Mutex{}
+
+	if !emptyUntilCreated {
+		disks[TestDiskUUID] = &testDisk{ SourceImageType: "EMPTY", Status: "Active", UUID: TestDiskUUID }
+	}
+
+	// The test disk size is shared with the virtual machine endpoint
+	getDisk := func(diskUUID string) *testDisk {
+		disk, _ := disks[diskUUID]
+
+		if nil != disk && TestDiskUUID == diskUUID {
+			disk.SizeInGB = getTestDiskSizeInGB(mux)
+		}
+
+		return disk
+	}
+
+	setDiskSizeInGB := func(disk *testDisk, sizeInGB int) {
+		disk.SizeInGB = sizeInGB
+
+		if TestDiskUUID == disk.UUID {
+			setTestDiskSizeInGB(mux, sizeInGB)
+		}
+	}
+
+	getSnapshotDisk := func(snapshotUUID string) *testDisk {
+		for diskUUID := range disks {
+			disk := getDisk(diskUUID)
+
+			if "" != snapshotUUID && disk.SnapshotUUID == snapshotUUID {
+				return disk
+			}
+		}
+
+		return nil
+	}
 
 	mux.HandleFunc(fmt.Sprintf("%s/disks", baseURL), func(res http.ResponseWriter, req *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
 		res.Header().Add("Content-Type", "application/json; charset=utf-8")
 
 		if strings.ToLower(req.Method) == "get" {
-			queryParams := req.URL.Query()
-
-			if queryParams.Get("network_uuid") == TestDiskUUID && isDiskCreated {
-				res.WriteHeader(http.StatusOK)
-				res.Write([]byte(fmt.Sprintf(jsonDiskDataTemplate, TestDiskUUID)))
-			} else {
-				res.WriteHeader(http.StatusNotFound)
-				res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
+			res.WriteHeader(http.StatusOK)
+
+			jsonDisks := []string{}
+
+			for _, diskUUID := range testDiskUUIDs {
+				disk := getDisk(diskUUID)
+
+				if nil != disk {
+					jsonDisks = append(jsonDisks, newJsonDiskData(disk))
+				}
 			}
+
+			res.Write([]byte(fmt.Sprintf("[%s]", strings.Join(jsonDisks, ","))))
 		} else if strings.ToLower(req.Method) == "post" {
-			isDiskCreated = true
-			res.WriteHeader(http.StatusCreated)
+			disk := &testDisk{ SourceImageType: "EMPTY", Status: "Creating" }
+
+			for _, diskUUID := range testDiskUUIDs {
+				if nil == getDisk(diskUUID) {
+					disk.UUID = diskUUID
+					break
+				}
+			}
+
+			if "" == disk.UUID {
+				res.WriteHeader(http.StatusConflict)
+				res.Write([]byte(`{ "errors": { "Error": "Disk limit exceeded" } }`))
+
+				return
+			}
+
+			sizeInGB := getDiskSizeInGBFromRequest(req)
+			formParams := req.PostForm
+
+			if "SNAPSHOT" == formParams.Get("source_image_type") {
+				snapshotDisk := getSnapshotDisk(formParams.Get("source_image"))
+
+				if nil == snapshotDisk {
+					res.WriteHeader(http.StatusNotFound)
+					res.Write([]byte(`{ "errors": { "source_image": "Snapshot not found" } }`))
+
+					return
+				}
+
+				if sizeInGB < snapshotDisk.SnapshotSizeInGB {
+					res.WriteHeader(http.StatusBadRequest)
+					res.Write([]byte(`{ "errors": { "size_gb": "Disk size can not be smaller than the snapshot" } }`))
+
+					return
+				}
+
+				disk.SourceImage = snapshotDisk.SnapshotUUID
+				disk.SourceImageType = "SNAPSHOT"
+			} else if "DISK" == formParams.Get("source_image_type") {
+				sourceDisk := getDisk(formParams.Get("source_image"))
+
+				if nil == sourceDisk {
+					res.WriteHeader(http.StatusNotFound)
+					res.Write([]byte(`{ "errors": { "source_image": "Disk not found" } }`))
+
+					return
+				}
+
+				if sizeInGB < sourceDisk.SizeInGB {
+					res.WriteHeader(http.StatusBadRequest)
+					res.Write([]byte(`{ "errors": { "size_gb": "Disk size can not be smaller than the source disk" } }`))
+
+					return
+				}
+
+				disk.SourceImage = sourceDisk.UUID
+				disk.SourceImageType = "DISK"
+			} else if "EXTERNAL" == formParams.Get("source_image_type") {
+				sourceImageURL := formParams.Get("source_image")
+
+				if !strings.HasPrefix(sourceImageURL, "http://") && !strings.HasPrefix(sourceImageURL, "https://") {
+					res.WriteHeader(http.StatusBadRequest)
+					res.Write([]byte(`{ "errors": { "source_image": "Invalid external image URL" } }`))
+
+					return
+				}
+
+				disk.SourceImage = sourceImageURL
+				disk.SourceImageType = "EXTERNAL"
+			}
+
+			if statusComment, ok := popTestDiskCreationFailure(mux); ok {
+				disk.Status = "Error"
+				disk.StatusComment = statusComment
+			}
 
-			res.Write([]byte(fmt.Sprintf(jsonDiskDataTemplate, TestDiskUUID)))
+			disks[disk.UUID] = disk
+			setDiskSizeInGB(disk, sizeInGB)
+
+			res.WriteHeader(http.StatusCreated)
+			res.Write([]byte(newJsonDiskData(disk)))
 		} else {
 			panic("Unsupported HTTP method call")
 		}
 	})
 
-	mux.HandleFunc(fmt.Sprintf("%s/disk/%s", baseURL, TestDiskUUID), func(res http.ResponseWriter, req *http.Request) {
+	mux.HandleFunc(fmt.Sprintf("%s/disk/", baseURL), func(res http.ResponseWriter, req *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
 		res.Header().Add("Content-Type", "application/json; charset=utf-8")
 
-		if strings.ToLower(req.Method) == "delete" {
-			isDiskCreated = false
+		pathData := strings.Split(strings.TrimPrefix(req.URL.Path, fmt.Sprintf("%s/disk/", baseURL)), "/")
+		disk := getDisk(pathData[0])
+
+		if nil == disk {
+			res.WriteHeader(http.StatusNotFound)
+			res.Write([]byte(`{ "errors": { "Error": "[404] Disk not found" } }`))
+
+			return
+		}
+
+		if len(pathData) > 1 {
+			if "snapshot" != pathData[1] || strings.ToLower(req.Method) != "post" {
+				panic("Unsupported HTTP method call")
+			}
+
+			if "" != disk.SnapshotUUID {
+				res.WriteHeader(http.StatusConflict)
+				res.Write([]byte(`{ "errors": { "Error": "Snapshot already exists" } }`))
+
+				return
+			}
+
+			disk.SnapshotSizeInGB = disk.SizeInGB
+			disk.SnapshotUUID = TestDiskSnapshotUUID
+
+			res.WriteHeader(http.StatusCreated)
+			res.Write([]byte(newJsonDiskSnapshotData(disk)))
+		} else if strings.ToLower(req.Method) == "delete" {
+			delete(disks, disk.UUID)
 			res.WriteHeader(http.StatusAccepted)
 		} else if strings.ToLower(req.Method) == "get" {
-			if isDiskCreated {
-				res.WriteHeader(http.StatusOK)
-				res.Write([]byte(fmt.Sprintf(jsonDiskDataTemplate, TestDiskUUID)))
-			} else {
-				res.WriteHeader(http.StatusNotFound)
-				res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
+			res.WriteHeader(http.StatusOK)
+			res.Write([]byte(newJsonDiskData(disk)))
+
+			// Disks are created and resized asynchronously and become active after being read once
+			if "Creating" == disk.Status {
+				disk.Status = "Active"
+			} else if "Resizing" == disk.Status {
+				setDiskSizeInGB(disk, disk.ResizedSizeInGB)
+				disk.Status = "Active"
 			}
 		} else if strings.ToLower(req.Method) == "patch" {
-			if isDiskCreated {
-				res.WriteHeader(http.StatusOK)
-				res.Write([]byte(fmt.Sprintf(jsonDiskDataTemplate, TestDiskUUID)))
-			} else {
-				res.WriteHeader(http.StatusNotFound)
-				res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
+			newDiskSizeInGB := getDiskSizeInGBFromRequest(req)
+
+			if newDiskSizeInGB < disk.SizeInGB {
+				res.WriteHeader(http.StatusBadRequest)
+				res.Write([]byte(`{ "errors": { "size_gb": "Disk size can not be decreased" } }`))
+
+				return
 			}
+
+			disk.ResizedSizeInGB = newDiskSizeInGB
+			disk.Status = "Resizing"
+
+			res.WriteHeader(http.StatusOK)
+			res.Write([]byte(newJsonDiskData(disk)))
+		} else {
+			panic("Unsupported HTTP method call")
+		}
+	})
+
+	mux.HandleFunc(fmt.Sprintf("%s/snapshot/", baseURL), func(res http.ResponseWriter, req *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		res.Header().Add("Content-Type", "application/json; charset=utf-8")
+
+		disk := getSnapshotDisk(strings.TrimPrefix(req.URL.Path, fmt.Sprintf("%s/snapshot/", baseURL)))
+
+		if nil == disk {
+			res.WriteHeader(http.StatusNotFound)
+			res.Write([]byte(`{ "errors": { "Error": "[404] Snapshot not found" } }`))
+		} else if strings.ToLower(req.Method) == "delete" {
+			disk.SnapshotUUID = ""
+			res.WriteHeader(http.StatusAccepted)
 		} else {
 			panic("Unsupported HTTP method call")
 		}
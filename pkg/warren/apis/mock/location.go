@@ -25,11 +25,11 @@ import (
 
 const TestLocationDisplayName = "Cycletown"
 
-// SetupLocationEndpointOnMux configures a "/networks" endpoint on the mux given.
+// SetupLocationEndpointOnMux configures a "/config/locations" endpoint on the mux given.
 //
 // PARAMETERS
-// mux *http.ServeMux Mux to add handler to
-func SetupLocationEndpointOnMux(mux *http.ServeMux) {
+// mux *TestMux Mux to add handler to
+func SetupLocationEndpointOnMux(mux *TestMux) {
 	mux.HandleFunc("/v1/cyc01/config/locations", func(res http.ResponseWriter, req *http.Request) {
 		res.Header().Add("Content-Type", "application/json; charset=utf-8")
 
@@ -38,6 +38,15 @@ func SetupLocationEndpointOnMux(mux *http.ServeMux) {
 
 			res.Write([]byte(fmt.Sprintf(`
 [
+	{
+		"display_name": "Pedaltown",
+		"is_default": false,
+		"is_preferred": true,
+		"description": "The newer location",
+		"order_nr": 2,
+		"slug": "ped01",
+		"country_code": "lva"
+	},
 	{
 		"display_name": %q,
 		"is_default": true,
@@ -35,7 +35,7 @@ const (
 	"enabled": true,
 	"created_at": "2019-10-31 10:52:19",
 	"updated_at": "2019-11-01 10:22:19",
-	"uuid": "3456789a-bcde-4012-3f56-789abcdef012",
+	"uuid": %q,
 	"is_deleted": false,
 	"is_ipv6": false,
 	"assigned_to": "01234567-89ab-4def-0123-c56789abcdef",
@@ -52,21 +52,66 @@ const (
     "updated_at": "2021-06-29 08:22:52",
     "uuid": %q,
     "type": "private",
-    "is_default": true,
+    "is_default": %t,
     "vm_uuids": [ "01234567-89ab-4def-0123-c56789abcdef" ],
     "resources_count": 0
 }
 	`
+	TestDefaultNetworkUUID = "456789ab-cdef-4012-3456-f89abcdef012"
 	TestFloatingIP = "42.42.42.42"
 	TestFloatingIPID = 42
+	TestFloatingIPUUID = "3456789a-bcde-4012-3f56-789abcdef012"
 	TestNetworkUUID = "23456789-abcd-4f01-23e5-6789abcdef01"
 )
 
+// AddTestDefaultNetwork adds another network to the network endpoint being
+// the default one until the test network is set as default.
+//
+// PARAMETERS
+// mux *TestMux Mux the network endpoint is configured on
+func AddTestDefaultNetwork(mux *TestMux) {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	isDefault := true
+	mux.defaultNetworkEnabled = &isDefault
+}
+
+// getTestDefaultNetworkData returns the JSON data of the other default
+// network or false if it has not been added.
+//
+// PARAMETERS
+// mux *TestMux Mux the network endpoint is configured on
+func getTestDefaultNetworkData(mux *TestMux) (string, bool) {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	if nil == mux.defaultNetworkEnabled {
+		return "", false
+	}
+
+	return fmt.Sprintf(jsonNetworkDataTemplate, TestDefaultNetworkUUID, *mux.defaultNetworkEnabled), true
+}
+
+// unsetTestDefaultNetwork removes the default flag of the other default
+// network if it has been added.
+//
+// PARAMETERS
+// mux *TestMux Mux the network endpoint is configured on
+func unsetTestDefaultNetwork(mux *TestMux) {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	if nil != mux.defaultNetworkEnabled {
+		*mux.defaultNetworkEnabled = false
+	}
+}
+
 // SetupIPAddressesEndpointOnMux configures a "/networks" endpoint on the mux given.
 //
 // PARAMETERS
-// mux *http.ServeMux Mux to add handler to
-func SetupIPAddressesEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
+// mux *TestMux Mux to add handler to
+func SetupIPAddressesEndpointOnMux(mux *TestMux, emptyUntilCreated bool) {
 	baseURL := "/v1/cyc01/network/ip_addresses"
 	isFloatingIPCreated := !emptyUntilCreated
 
@@ -78,7 +123,7 @@ func SetupIPAddressesEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 			res.Write([]byte("["))
 
 			if isFloatingIPCreated {
-				res.Write([]byte(fmt.Sprintf(jsonFloatingIPDataTemplate, TestFloatingIPID, TestFloatingIP)))
+				res.Write([]byte(fmt.Sprintf(jsonFloatingIPDataTemplate, TestFloatingIPID, TestFloatingIP, TestFloatingIPUUID)))
 			}
 
 			res.Write([]byte("]"))
@@ -86,7 +131,7 @@ func SetupIPAddressesEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 			isFloatingIPCreated = true
 			res.WriteHeader(http.StatusCreated)
 
-			res.Write([]byte(fmt.Sprintf(jsonFloatingIPDataTemplate, TestFloatingIPID, TestFloatingIP)))
+			res.Write([]byte(fmt.Sprintf(jsonFloatingIPDataTemplate, TestFloatingIPID, TestFloatingIP, TestFloatingIPUUID)))
 		} else {
 			panic("Unsupported HTTP method call")
 		}
@@ -110,7 +155,7 @@ func SetupIPAddressesEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 		res.Write([]byte("["))
 
 		if isFloatingIPCreated {
-			res.Write([]byte(fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID)))
+			res.Write([]byte(fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID, true)))
 		}
 
 		res.Write([]byte("]"))
@@ -120,19 +165,22 @@ func SetupIPAddressesEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 // SetupNetworkEndpointOnMux configures a "/networks" endpoint on the mux given.
 //
 // PARAMETERS
-// mux *http.ServeMux Mux to add handler to
-func SetupNetworkEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
+// mux *TestMux Mux to add handler to
+func SetupNetworkEndpointOnMux(mux *TestMux, emptyUntilCreated bool) {
 	baseURL := "/v1/cyc01/network"
 	isNetworkCreated := !emptyUntilCreated
+	// Networks created are not set as default while the existing one is
+	isNetworkDefault := !emptyUntilCreated
 
 	mux.HandleFunc(fmt.Sprintf("%s/network", baseURL), func(res http.ResponseWriter, req *http.Request) {
 		res.Header().Add("Content-Type", "application/json; charset=utf-8")
 
 		if strings.ToLower(req.Method) == "post" {
 			isNetworkCreated = true
+			isNetworkDefault = false
 			res.WriteHeader(http.StatusCreated)
 
-			res.Write([]byte(fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID)))
+			res.Write([]byte(fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID, isNetworkDefault)))
 		} else {
 			panic("Unsupported HTTP method call")
 		}
@@ -141,13 +189,28 @@ func SetupNetworkEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 	mux.HandleFunc(fmt.Sprintf("%s/network/%s/", baseURL, TestNetworkUUID), func(res http.ResponseWriter, req *http.Request) {
 		res.Header().Add("Content-Type", "application/json; charset=utf-8")
 
-		if strings.ToLower(req.Method) == "delete" {
+		if strings.HasSuffix(req.URL.Path, "/default") {
+			if strings.ToLower(req.Method) != "put" {
+				panic("Unsupported HTTP method call")
+			}
+
+			if isNetworkCreated {
+				isNetworkDefault = true
+				unsetTestDefaultNetwork(mux)
+
+				res.WriteHeader(http.StatusOK)
+				res.Write([]byte(fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID, isNetworkDefault)))
+			} else {
+				res.WriteHeader(http.StatusNotFound)
+				res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
+			}
+		} else if strings.ToLower(req.Method) == "delete" {
 			isNetworkCreated = false
 			res.WriteHeader(http.StatusAccepted)
 		} else if strings.ToLower(req.Method) == "get" {
 			if isNetworkCreated {
 				res.WriteHeader(http.StatusOK)
-				res.Write([]byte(fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID)))
+				res.Write([]byte(fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID, isNetworkDefault)))
 			} else {
 				res.WriteHeader(http.StatusNotFound)
 				res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
@@ -155,7 +218,7 @@ func SetupNetworkEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 		} else if strings.ToLower(req.Method) == "patch" {
 			if isNetworkCreated {
 				res.WriteHeader(http.StatusOK)
-				res.Write([]byte(fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID)))
+				res.Write([]byte(fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID, isNetworkDefault)))
 			} else {
 				res.WriteHeader(http.StatusNotFound)
 				res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
@@ -168,13 +231,17 @@ func SetupNetworkEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 	mux.HandleFunc(fmt.Sprintf("%s/networks", baseURL), func(res http.ResponseWriter, req *http.Request) {
 		res.Header().Add("Content-Type", "application/json; charset=utf-8")
 
-		res.WriteHeader(http.StatusOK)
-		res.Write([]byte("["))
+		networksData := []string{}
 
 		if isNetworkCreated {
-			res.Write([]byte(fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID)))
+			networksData = append(networksData, fmt.Sprintf(jsonNetworkDataTemplate, TestNetworkUUID, isNetworkDefault))
 		}
 
-		res.Write([]byte("]"))
+		if defaultNetworkData, ok := getTestDefaultNetworkData(mux); ok {
+			networksData = append(networksData, defaultNetworkData)
+		}
+
+		res.WriteHeader(http.StatusOK)
+		res.Write([]byte(fmt.Sprintf("[%s]", strings.Join(networksData, ","))))
 	})
 }
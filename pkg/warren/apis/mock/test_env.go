@@ -21,14 +21,40 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
 
 	"gitlab.com/warrenio/library/go-client/warren"
+	"gitlab.com/warrenio/library/terraform-provider-warren/pkg/warren/apis"
 )
 
+// TestMux is the HTTP request multiplexer of a test environment. It holds the
+// state shared between the endpoints simulated on it.
+type TestMux struct {
+	*http.ServeMux
+
+	attachedDiskUUIDs     []string
+	defaultNetworkEnabled *bool
+	diskCreationFailure   *string
+	diskSizeInGB          int
+	lockedDetachRequests  int
+	mutex                 sync.Mutex
+}
+
+// cassetteRecordTransport sends requests recorded to the Warren Platform API
+// with the real API token instead of the test one.
+type cassetteRecordTransport struct {
+	apiToken string
+}
+
 // MockTestEnv represents the test environment for testing Warren Platform API calls
 type MockTestEnv struct {
 	Server         *httptest.Server
-	Mux            *http.ServeMux
+	Mux            *TestMux
+	Cassette       *CassetteTransport
 	Client         *warren.Client
 	ProviderConfig string
 }
@@ -39,23 +65,49 @@ const (
 	TestPlacementGroupID = "42"
 	testPlacementGroupJsonValue = float64(42)
 	TestSSHKey = "ssh-rsa invalid"
+	testCassetteURL = "https://api.cassette.invalid"
+	testLocationSlug = "cyc01"
+	testToken = "dummy-token"
 )
 
+// RoundTrip executes the given HTTP request with the real API token.
+//
+// PARAMETERS
+// req *http.Request HTTP request
+func (t *cassetteRecordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("apikey", t.apiToken)
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
 // Teardown shuts down the test environment server
 func (env *MockTestEnv) Teardown() {
-	env.Server.Close()
+	if nil != env.Server {
+		env.Server.Close()
+	}
+
+	if nil != env.Cassette {
+		apis.SetHTTPClientForToken(testToken, nil)
+
+		err := env.Cassette.GetUnusedInteractionsError()
+		if nil != err {
+			panic(err)
+		}
+	}
 
 	env.Server = nil
+	env.Cassette = nil
 	env.Mux = nil
 	env.Client = nil
 }
 
 // NewMockTestEnv generates a new, unconfigured test environment for testing purposes.
 func NewMockTestEnv() MockTestEnv {
-	mux := http.NewServeMux()
+	mux := &TestMux{ ServeMux: http.NewServeMux(), diskSizeInGB: TestDiskSizeInGB }
 	server := httptest.NewServer(mux)
 
-	client, err := (&warren.ClientBuilder{}).ApiUrl(server.URL).ApiToken("dummy-token").LocationSlug("cyc01").Build()
+	client, err := (&warren.ClientBuilder{}).ApiUrl(server.URL).ApiToken(testToken).LocationSlug(testLocationSlug).Build()
 	if nil != err {
 		panic(err)
 	}
@@ -63,11 +115,13 @@ func NewMockTestEnv() MockTestEnv {
 	config := fmt.Sprintf(
 		`
 provider "warren" {
-	api_token = "dummy-token"
-	api_url   = "%s/v1/cyc01"
+	api_token = %q
+	api_url   = "%s/v1/%s"
 }
 		`,
+		testToken,
 		client.BaseURL.String(),
+		testLocationSlug,
 	)
 
 	return MockTestEnv{
@@ -77,3 +131,85 @@ provider "warren" {
 		ProviderConfig: config,
 	}
 }
+
+// NewCassetteTestEnv generates a new test environment replaying the cassette
+// fixture given. Teardown fails if any interaction has not been replayed.
+//
+// The cassette is recorded against the Warren Platform API instead if
+// "WARREN_CASSETTE_MODE" is set to "record". "WARREN_API_URL",
+// "WARREN_API_TOKEN" and optionally "WARREN_API_LOCATION" configure the API
+// used for recording.
+//
+// PARAMETERS
+// name string Cassette fixture name without file extension
+func NewCassetteTestEnv(name string) MockTestEnv {
+	_, filePath, _, _ := runtime.Caller(0)
+	cassettePath := filepath.Join(filepath.Dir(filePath), "cassettes", fmt.Sprintf("%s.json", name))
+
+	apiURL := testCassetteURL
+	locationSlug := testLocationSlug
+
+	var (
+		cassette *CassetteTransport
+		err      error
+	)
+
+	if CassetteModeRecord == CassetteMode(os.Getenv("WARREN_CASSETTE_MODE")) {
+		apiURL = strings.TrimSuffix(strings.TrimSuffix(os.Getenv("WARREN_API_URL"), "/"), "/v1")
+
+		if "" != os.Getenv("WARREN_API_LOCATION") {
+			locationSlug = os.Getenv("WARREN_API_LOCATION")
+		}
+
+		cassette, err = NewCassetteTransport(
+			CassetteModeRecord,
+			cassettePath,
+			&cassetteRecordTransport{ apiToken: os.Getenv("WARREN_API_TOKEN") },
+		)
+
+		if nil == err {
+			cassette.cassette.LocationSlug = locationSlug
+		}
+	} else {
+		cassette, err = NewCassetteTransport(CassetteModeReplay, cassettePath, nil)
+
+		if nil == err && "" != cassette.cassette.LocationSlug {
+			locationSlug = cassette.cassette.LocationSlug
+		}
+	}
+
+	if nil != err {
+		panic(err)
+	}
+
+	httpClient := &http.Client{ Transport: cassette }
+	apis.SetHTTPClientForToken(testToken, httpClient)
+
+	client, err := (&warren.ClientBuilder{}).
+		ApiUrl(apiURL).
+		ApiToken(testToken).
+		LocationSlug(locationSlug).
+		Client(httpClient).
+		Build()
+	if nil != err {
+		panic(err)
+	}
+
+	config := fmt.Sprintf(
+		`
+provider "warren" {
+	api_token = %q
+	api_url   = "%s/v1/%s"
+}
+		`,
+		testToken,
+		apiURL,
+		locationSlug,
+	)
+
+	return MockTestEnv{
+		Cassette:       cassette,
+		Client:         client,
+		ProviderConfig: config,
+	}
+}
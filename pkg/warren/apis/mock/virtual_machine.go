@@ -45,6 +45,28 @@ const (
 			"published": true
 		}
 	]
+}
+	`
+	jsonAppCatalogImageData = `
+{
+	"os_name": "wordpress",
+	"display_name": "WordPress",
+	"ui_position": 2,
+	"is_default": false,
+	"is_app_catalog": true,
+	"icon": "...",
+	"versions": [
+		{
+			"os_version": "6.2",
+			"display_name": "6.2",
+			"published": true
+		},
+		{
+			"os_version": "6.3",
+			"display_name": "6.3 (Beta)",
+			"published": false
+		}
+	]
 }
 	`
 	jsonServerDataTemplate = `
@@ -76,12 +98,12 @@ const (
 	{
 		"created_at": "2018-02-22 14:24:30.312877",
 		"id": 42,
-		"name": "sda",
+		"name": %q,
 		"pool": "default2",
-		"primary": true,
+		"primary": %t,
 		"replica": [],
 		"shared": false,
-		"size": 20,
+		"size": %d,
 		"type": "block",
 		"updated_at": null,
 		"user_id": 8,
@@ -95,10 +117,23 @@ const (
 // newJsonServerData generates a JSON server data object for testing purposes.
 //
 // PARAMETERS
-// serverUUID  string Server ID to use
-// serverState string Server state to use
-func newJsonServerData(serverUUID string, serverState string) string {
+// serverUUID        string   Server ID to use
+// serverState       string   Server state to use
+// diskSizeInGB      int      Server boot disk size to use
+// attachedDiskUUIDs []string Disk IDs attached to the server including the boot disk
+func newJsonServerData(serverUUID string, serverState string, diskSizeInGB int, attachedDiskUUIDs []string) string {
 	testServerName := fmt.Sprintf(TestServerNameTemplate, serverUUID)
+	storageData := []string{}
+
+	for index, diskUUID := range attachedDiskUUIDs {
+		sizeInGB := TestDiskSizeInGB
+
+		if TestDiskUUID == diskUUID {
+			sizeInGB = diskSizeInGB
+		}
+
+		storageData = append(storageData, newJsonServerStorageData(diskUUID, sizeInGB, index))
+	}
 
 	return fmt.Sprintf(
 		jsonServerDataTemplate,
@@ -106,31 +141,157 @@ func newJsonServerData(serverUUID string, serverState string) string {
 		testServerName,
 		testServerName,
 		serverState,
-		newJsonServerStorageData(TestDiskUUID),
+		strings.Join(storageData, ","),
 	)
 }
 
-// newJsonServerData generates a JSON server data object for testing purposes.
+// indexOfDiskUUID returns the index of the disk ID given or -1 if it is not
+// attached.
+//
+// PARAMETERS
+// attachedDiskUUIDs []string Disk IDs attached
+// diskUUID          string   Disk ID to look for
+func indexOfDiskUUID(attachedDiskUUIDs []string, diskUUID string) int {
+	for index, attachedDiskUUID := range attachedDiskUUIDs {
+		if attachedDiskUUID == diskUUID {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// LockTestServerDetach rejects the next detach requests of the test server
+// with the server being locked until it is stopped.
+//
+// PARAMETERS
+// mux      *TestMux Mux the virtual machine endpoint is configured on
+// requests int      Number of detach requests to reject
+func LockTestServerDetach(mux *TestMux, requests int) {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	mux.lockedDetachRequests = requests
+}
+
+// isTestServerDetachLocked returns true if the detach request is rejected as
+// the test server is locked.
+//
+// PARAMETERS
+// mux *TestMux Mux the virtual machine endpoint is configured on
+func isTestServerDetachLocked(mux *TestMux) bool {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	if mux.lockedDetachRequests < 1 {
+		return false
+	}
+
+	mux.lockedDetachRequests--
+
+	return true
+}
+
+// resetTestServerDisks detaches all disks from the test server except its
+// boot disk.
+//
+// PARAMETERS
+// mux *TestMux Mux the virtual machine endpoint is configured on
+func resetTestServerDisks(mux *TestMux) {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	mux.attachedDiskUUIDs = []string{ TestDiskUUID }
+}
+
+// getTestServerDiskUUIDs returns a copy of the disk IDs attached to the test
+// server including the boot disk.
+//
+// PARAMETERS
+// mux *TestMux Mux the virtual machine endpoint is configured on
+func getTestServerDiskUUIDs(mux *TestMux) []string {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	return append([]string{}, mux.attachedDiskUUIDs...)
+}
+
+// attachTestServerDisk attaches the disk ID given to the test server and
+// returns its disk index or -1 if it can not be attached.
+//
+// PARAMETERS
+// mux      *TestMux Mux the virtual machine endpoint is configured on
+// diskUUID string   Disk ID to attach
+func attachTestServerDisk(mux *TestMux, diskUUID string) int {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	if diskIndex := indexOfDiskUUID(mux.attachedDiskUUIDs, diskUUID); diskIndex > -1 {
+		return diskIndex
+	}
+
+	if TestDiskUUID != diskUUID && TestSecondDiskUUID != diskUUID {
+		return -1
+	}
+
+	mux.attachedDiskUUIDs = append(mux.attachedDiskUUIDs, diskUUID)
+
+	return len(mux.attachedDiskUUIDs) - 1
+}
+
+// detachTestServerDisk detaches the disk ID given from the test server and
+// returns false if it is not attached.
 //
 // PARAMETERS
-// serverUUID  string Server ID to use
-// serverState string Server state to use
-func newJsonServerStorageData(diskUUID string) string {
-	return fmt.Sprintf(jsonServerStorageDataTemplate, diskUUID)
+// mux      *TestMux Mux the virtual machine endpoint is configured on
+// diskUUID string   Disk ID to detach
+func detachTestServerDisk(mux *TestMux, diskUUID string) bool {
+	mux.mutex.Lock()
+	defer mux.mutex.Unlock()
+
+	diskIndex := indexOfDiskUUID(mux.attachedDiskUUIDs, diskUUID)
+
+	if diskIndex < 0 {
+		return false
+	}
+
+	mux.attachedDiskUUIDs = append(mux.attachedDiskUUIDs[:diskIndex:diskIndex], mux.attachedDiskUUIDs[diskIndex + 1:]...)
+
+	return true
+}
+
+// newJsonServerStorageData generates a JSON server storage data object for
+// testing purposes.
+//
+// PARAMETERS
+// diskUUID     string Disk ID to use
+// diskSizeInGB int    Disk size to use
+// diskIndex    int    Disk index with the primary disk being 0
+func newJsonServerStorageData(diskUUID string, diskSizeInGB int, diskIndex int) string {
+	return fmt.Sprintf(
+		jsonServerStorageDataTemplate,
+		fmt.Sprintf("sd%c", 'a' + diskIndex),
+		0 == diskIndex,
+		diskSizeInGB,
+		diskUUID,
+	)
 }
 
 // SetupVMEndpointOnMux configures a "/v1/user-resource/vm" endpoint on the mux given.
 //
 // PARAMETERS
-// mux *http.ServeMux Mux to add handler to
-func SetupVMEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
+// mux *TestMux Mux to add handler to
+func SetupVMEndpointOnMux(mux *TestMux, emptyUntilCreated bool) {
 	baseURL := "/v1/cyc01/user-resource/vm"
 	isVMCreated := !emptyUntilCreated
 
+	resetTestServerDisks(mux)
+
 	mux.HandleFunc(baseURL, func(res http.ResponseWriter, req *http.Request) {
 		res.Header().Add("Content-Type", "application/json; charset=utf-8")
 
 		if strings.ToLower(req.Method) == "delete" {
+			resetTestServerDisks(mux)
 			isVMCreated = false
 			res.WriteHeader(http.StatusOK)
 		} else if strings.ToLower(req.Method) == "get" {
@@ -138,7 +299,7 @@ func SetupVMEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 
 			if queryParams.Get("uuid") == TestServerUUID && isVMCreated {
 				res.WriteHeader(http.StatusOK)
-				res.Write([]byte(newJsonServerData(TestServerUUID, "started")))
+				res.Write([]byte(newJsonServerData(TestServerUUID, "started", getTestDiskSizeInGB(mux), getTestServerDiskUUIDs(mux))))
 			} else {
 				res.WriteHeader(http.StatusNotFound)
 				res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
@@ -154,10 +315,15 @@ func SetupVMEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 				panic(jsonErr)
 			}
 
+			if diskSizeInGB, ok := data["disks"].(float64); ok {
+				setTestDiskSizeInGB(mux, int(diskSizeInGB))
+			}
+
+			resetTestServerDisks(mux)
 			isVMCreated = true
 			res.WriteHeader(http.StatusCreated)
 
-			res.Write([]byte(newJsonServerData(TestServerUUID, "stopped")))
+			res.Write([]byte(newJsonServerData(TestServerUUID, "stopped", getTestDiskSizeInGB(mux), getTestServerDiskUUIDs(mux))))
 		} else {
 			panic("Unsupported HTTP method call")
 		}
@@ -172,7 +338,7 @@ func SetupVMEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 			res.Write([]byte("["))
 
 			if isVMCreated {
-				res.Write([]byte(newJsonServerData(TestServerUUID, "running")))
+				res.Write([]byte(newJsonServerData(TestServerUUID, "running", getTestDiskSizeInGB(mux), getTestServerDiskUUIDs(mux))))
 			}
 
 			res.Write([]byte("]"))
@@ -186,7 +352,21 @@ func SetupVMEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 
 		if isVMCreated {
 			res.WriteHeader(http.StatusOK)
-			res.Write([]byte(newJsonServerData(TestServerUUID, "started")))
+			res.Write([]byte(newJsonServerData(TestServerUUID, "started", getTestDiskSizeInGB(mux), getTestServerDiskUUIDs(mux))))
+		} else {
+			res.WriteHeader(http.StatusNotFound)
+			res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
+		}
+	})
+
+	mux.HandleFunc(fmt.Sprintf("%s/stop", baseURL), func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Add("Content-Type", "application/json; charset=utf-8")
+
+		if isVMCreated {
+			LockTestServerDetach(mux, 0)
+
+			res.WriteHeader(http.StatusOK)
+			res.Write([]byte(newJsonServerData(TestServerUUID, "stopped", getTestDiskSizeInGB(mux), getTestServerDiskUUIDs(mux))))
 		} else {
 			res.WriteHeader(http.StatusNotFound)
 			res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
@@ -202,13 +382,43 @@ func SetupVMEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 		}
 
 		formParams := req.PostForm
+		diskUUID := formParams.Get("storage_uuid")
 
-		if formParams.Get("uuid") == TestServerUUID && formParams.Get("storage_uuid") == TestDiskUUID && isVMCreated {
+		if formParams.Get("uuid") != TestServerUUID || !isVMCreated {
+			res.WriteHeader(http.StatusNotFound)
+			res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
+		} else if diskIndex := attachTestServerDisk(mux, diskUUID); diskIndex > -1 {
 			res.WriteHeader(http.StatusOK)
-			res.Write([]byte(newJsonServerStorageData(TestDiskUUID)))
+			res.Write([]byte(newJsonServerStorageData(diskUUID, TestDiskSizeInGB, diskIndex)))
 		} else {
+			res.WriteHeader(http.StatusBadRequest)
+			res.Write([]byte(`{ "errors": { "storage_uuid": "Disk can not be attached" } }`))
+		}
+	})
+
+	mux.HandleFunc(fmt.Sprintf("%s/storage/detach", baseURL), func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Add("Content-Type", "application/json; charset=utf-8")
+
+		err := req.ParseForm()
+		if nil != err {
+			panic(err)
+		}
+
+		formParams := req.PostForm
+		diskUUID := formParams.Get("storage_uuid")
+
+		if formParams.Get("uuid") != TestServerUUID || !isVMCreated {
 			res.WriteHeader(http.StatusNotFound)
 			res.Write([]byte(`{ "errors": { "Error": "[404] Server not found" } }`))
+		} else if isTestServerDetachLocked(mux) {
+			res.WriteHeader(http.StatusConflict)
+			res.Write([]byte(`{ "errors": { "Error": "[409] Server is locked" } }`))
+		} else if detachTestServerDisk(mux, diskUUID) {
+			res.WriteHeader(http.StatusOK)
+			res.Write([]byte("{}"))
+		} else {
+			res.WriteHeader(http.StatusBadRequest)
+			res.Write([]byte(`{ "errors": { "storage_uuid": "Disk is not attached" } }`))
 		}
 	})
 }
@@ -216,13 +426,13 @@ func SetupVMEndpointOnMux(mux *http.ServeMux, emptyUntilCreated bool) {
 // SetupVMImagesEndpointOnMux configures a "/v1/config/vm_images" endpoint on the mux given.
 //
 // PARAMETERS
-// mux *http.ServeMux Mux to add handler to
-func SetupVMImagesEndpointOnMux(mux *http.ServeMux) {
+// mux *TestMux Mux to add handler to
+func SetupVMImagesEndpointOnMux(mux *TestMux) {
 	mux.HandleFunc("/v1/cyc01/config/vm_images", func(res http.ResponseWriter, req *http.Request) {
 		res.Header().Add("Content-Type", "application/json; charset=utf-8")
 
 		res.WriteHeader(http.StatusOK)
 
-		res.Write([]byte(fmt.Sprintf("[ %s ]", jsonImageData)))
+		res.Write([]byte(fmt.Sprintf("[ %s, %s ]", jsonImageData, jsonAppCatalogImageData)))
 	})
 }
@@ -10,6 +10,7 @@ obtain one at http://mozilla.org/MPL/2.0/.
 package apis
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -42,20 +43,24 @@ func GetNetworkFromServerUUID(client *warren.Client, uuid string) (*warren.Netwo
 		return nil, GetServerErrorFromHttpCallError(err)
 	}
 
+	networks, err := client.Network.ListNetworks()
+	if err != nil {
+		return nil, GetNetworkErrorFromHttpCallError(err)
+	}
+
+	return GetNetworkFromServer(networks, server)
+}
+
+func GetNetworkFromServer(networks *[]warren.Network, server *warren.VirtualMachine) (*warren.Network, error) {
 	var serverPrivateIPv4 net.IP
 
 	if server.PrivateIPv4 != "" {
 		serverPrivateIPv4 = net.ParseIP(server.PrivateIPv4)
 	}
 
-	networks, err := client.Network.ListNetworks()
-	if err != nil {
-		return nil, GetNetworkErrorFromHttpCallError(err)
-	}
-
-	for _, network := range *networks {
+	for index, network := range *networks {
 		for _, networkServerUUID := range network.VmUuids {
-			if networkServerUUID == uuid {
+			if networkServerUUID == server.Uuid {
 				// Parse subnet only after we found a possible match
 				_, subnet, err := net.ParseCIDR(network.Subnet)
 				if nil != err {
@@ -63,11 +68,67 @@ func GetNetworkFromServerUUID(client *warren.Client, uuid string) (*warren.Netwo
 				}
 
 				if subnet.Contains(serverPrivateIPv4) {
-					return &network, nil
+					return &(*networks)[index], nil
 				}
 			}
 		}
 	}
 
-	return nil, fmt.Errorf("%w: Server UUID %s", ErrNetworkNotFound, uuid)
+	return nil, fmt.Errorf("%w: Server UUID %s", ErrNetworkNotFound, server.Uuid)
+}
+
+func GetNetworkUUIDsByServerUUID(client *warren.Client) (map[string]string, error) {
+	servers, err := client.VirtualMachine.ListVms()
+	if nil != err {
+		return nil, GetServerErrorFromHttpCallError(err)
+	}
+
+	networks, err := client.Network.ListNetworks()
+	if nil != err {
+		return nil, GetNetworkErrorFromHttpCallError(err)
+	}
+
+	networkUUIDs := make(map[string]string)
+
+	for index := range *servers {
+		network, err := GetNetworkFromServer(networks, &(*servers)[index])
+		if nil != err && !errors.Is(err, ErrNetworkNotFound) {
+			return nil, err
+		}
+
+		if nil != network {
+			networkUUIDs[(*servers)[index].Uuid] = network.Uuid
+		}
+	}
+
+	return networkUUIDs, nil
+}
+
+func GetNetworkByName(client *warren.Client, name string) (*warren.Network, error) {
+	networks, err := client.Network.ListNetworks()
+	if nil != err {
+		return nil, GetNetworkErrorFromHttpCallError(err)
+	}
+
+	var (
+		candidates []string
+		match      *warren.Network
+	)
+
+	for index, network := range *networks {
+		if network.Name == name {
+			candidates = append(candidates, network.Uuid)
+			match = &(*networks)[index]
+		}
+	}
+
+	if len(candidates) > 1 {
+		return nil, fmt.Errorf("%w for network name %s: %s", ErrAmbiguousMatch, name, strings.Join(candidates, ", "))
+	}
+
+	if nil == match {
+		return nil, fmt.Errorf("%w: No match found for name %s", ErrNetworkNotFound, name)
+	}
+
+	return match, nil
 }
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package apis is the main package for Warren specific APIs
+package apis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	pollIntervalMax = 10 * time.Second
+	pollIntervalMin = 250 * time.Millisecond
+)
+
+// Poll calls the condition function given with an increasing interval until
+// it returns true, an error or the timeout is exceeded.
+//
+// PARAMETERS
+// ctx       context.Context      Execution context
+// timeout   time.Duration        Timeout to wait for the condition
+// condition func() (bool, error) Condition function
+func Poll(ctx context.Context, timeout time.Duration, condition func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	interval := pollIntervalMin
+
+	for {
+		isDone, err := condition()
+		if nil != err || isDone {
+			return err
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("%w after %s", ErrTimeout, timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+
+		if interval > pollIntervalMax {
+			interval = pollIntervalMax
+		}
+	}
+}
+
+// RetryOnServerLocked calls the function given until it does not fail with
+// "ErrServerIsLocked" or the timeout is exceeded.
+//
+// PARAMETERS
+// ctx     context.Context Execution context
+// timeout time.Duration   Timeout to retry the function
+// fn      func() error    Function to call
+func RetryOnServerLocked(ctx context.Context, timeout time.Duration, fn func() error) error {
+	var lastErr error
+
+	err := Poll(ctx, timeout, func() (bool, error) {
+		lastErr = fn()
+
+		if errors.Is(lastErr, ErrServerIsLocked) {
+			tflog.Debug(ctx, fmt.Sprintf("Retrying as the server is locked: %s", lastErr))
+			return false, nil
+		}
+
+		return nil == lastErr, lastErr
+	})
+
+	if errors.Is(err, ErrTimeout) {
+		return fmt.Errorf("%w (%s)", lastErr, err.Error())
+	}
+
+	return err
+}
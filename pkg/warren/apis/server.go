@@ -10,8 +10,11 @@ obtain one at http://mozilla.org/MPL/2.0/.
 package apis
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"gitlab.com/warrenio/library/go-client/warren"
 )
@@ -59,3 +62,81 @@ func GetServerFromVolumeUUID(client *warren.Client, uuid string) (*warren.Virtua
 
 	return nil, fmt.Errorf("%w: No match found for UUID %s", ErrServerNotFound, uuid)
 }
+
+func GetServerUUIDsByVolumeUUID(client *warren.Client) (map[string]string, error) {
+	servers, err := client.VirtualMachine.ListVms()
+	if nil != err {
+		return nil, GetServerErrorFromHttpCallError(err)
+	}
+
+	serverUUIDs := make(map[string]string)
+
+	for _, server := range *servers {
+		for _, storage := range server.Storage {
+			serverUUIDs[storage.Uuid] = server.Uuid
+		}
+	}
+
+	return serverUUIDs, nil
+}
+
+func GetServerByName(client *warren.Client, name string) (*warren.VirtualMachine, error) {
+	servers, err := client.VirtualMachine.ListVms()
+	if nil != err {
+		return nil, GetServerErrorFromHttpCallError(err)
+	}
+
+	var (
+		candidates []string
+		match      *warren.VirtualMachine
+	)
+
+	for index, server := range *servers {
+		if server.Name == name {
+			candidates = append(candidates, server.Uuid)
+			match = &(*servers)[index]
+		}
+	}
+
+	if len(candidates) > 1 {
+		return nil, fmt.Errorf("%w for server name %s: %s", ErrAmbiguousMatch, name, strings.Join(candidates, ", "))
+	}
+
+	if nil == match {
+		return nil, fmt.Errorf("%w: No match found for name %s", ErrServerNotFound, name)
+	}
+
+	return match, nil
+}
+
+// WaitForVolumeDetached waits until the server given no longer lists the
+// volume given as attached storage.
+//
+// PARAMETERS
+// ctx        context.Context Execution context
+// client     *warren.Client  Warren client to use
+// serverUUID string          Server ID the volume has been detached from
+// volumeUUID string          Volume ID detached
+// timeout    time.Duration   Timeout to wait
+func WaitForVolumeDetached(ctx context.Context, client *warren.Client, serverUUID string, volumeUUID string, timeout time.Duration) error {
+	return Poll(ctx, timeout, func() (bool, error) {
+		server, err := client.VirtualMachine.GetByUuid(serverUUID)
+		if nil != err {
+			err = GetServerErrorFromHttpCallError(err)
+
+			if errors.Is(err, ErrServerNotFound) {
+				return true, nil
+			}
+
+			return false, err
+		}
+
+		for _, storage := range server.Storage {
+			if storage.Uuid == volumeUUID {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package apis is the main package for Warren specific APIs
+package apis
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.com/warrenio/library/go-client/warren"
+)
+
+func GetSnapshotErrorFromHttpCallError(err error) error {
+	if nil == err {
+		return nil
+	}
+
+	errString := err.Error()
+
+	if strings.HasPrefix(errString, "[") && strings.Index(errString, "]") == 4 {
+		switch errString[1:4] {
+		case "404":
+			return ErrSnapshotNotFound
+		default:
+			return GetErrorFromHttpCallError(err)
+		}
+	}
+
+	return err
+}
+
+// CreateSnapshot creates a new snapshot of the volume given.
+//
+// The vendored go-client does not support snapshots. The request is sent to a
+// sub-resource of the disk resource path the go-client uses for
+// "GetDiskById" ("/storage/disk/{uuid}"). The endpoint is not covered by a
+// recorded cassette and has not been verified against the API reference yet,
+// the mock disk endpoint only follows this request.
+//
+// PARAMETERS
+// client     *warren.Client Warren client
+// volumeUUID string         Volume UUID
+func CreateSnapshot(client *warren.Client, volumeUUID string) (*warren.Snapshot, error) {
+	var snapshot warren.Snapshot
+
+	err := callAPI(client, "POST", fmt.Sprintf("/storage/disk/%s/snapshot", volumeUUID), nil, &snapshot)
+	if nil != err {
+		return nil, GetVolumeErrorFromHttpCallError(err)
+	}
+
+	return &snapshot, nil
+}
+
+// DeleteSnapshot deletes the snapshot given.
+//
+// The vendored go-client does not support snapshots. The request is sent to
+// "/storage/snapshot/{uuid}" following the disk resource path the go-client
+// uses for "DeleteDiskById". The endpoint is not covered by a recorded
+// cassette and has not been verified against the API reference yet, the mock
+// disk endpoint only follows this request.
+//
+// PARAMETERS
+// client *warren.Client Warren client
+// uuid   string         Snapshot UUID
+func DeleteSnapshot(client *warren.Client, uuid string) error {
+	err := callAPI(client, "DELETE", "/storage/snapshot/" + uuid, nil, nil)
+	return GetSnapshotErrorFromHttpCallError(err)
+}
+
+// GetSnapshotByUUID returns the snapshot for the UUID given. The vendored
+// go-client has no snapshot lookup and no direct snapshot endpoint is known.
+// Snapshots are only returned as part of their volume by "ListUserDisks", so
+// all volumes are searched on every call and snapshots of deleted volumes are
+// not found.
+//
+// PARAMETERS
+// client *warren.Client Warren client
+// uuid   string         Snapshot UUID
+func GetSnapshotByUUID(client *warren.Client, uuid string) (*warren.Snapshot, error) {
+	disks, err := client.BlockStorage.ListUserDisks()
+	if nil != err {
+		return nil, GetVolumeErrorFromHttpCallError(err)
+	}
+
+	for _, disk := range *disks {
+		for _, snapshot := range disk.Snapshots {
+			if snapshot.Uuid == uuid {
+				if "" == snapshot.DiskUuid {
+					snapshot.DiskUuid = disk.Uuid
+				}
+
+				return &snapshot, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: No match found for UUID %s", ErrSnapshotNotFound, uuid)
+}
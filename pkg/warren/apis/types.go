@@ -16,6 +16,7 @@ const warrenDefaultURL = "https://api.equinix.warren.io/v1"
 
 //
 var (
+	ErrAmbiguousMatch       = errors.New("Multiple matches found")
 	ErrFloatingIPNotFound   = errors.New("Floating IP not found")
 	ErrImageNotFound        = errors.New("Image not found")
 	ErrLoadBalancerNotFound = errors.New("Load balancer not found")
@@ -24,6 +25,9 @@ var (
 	ErrNetworkNotFound      = errors.New("Network not found")
 	ErrServerIsLocked       = errors.New("Server is locked")
 	ErrServerNotFound       = errors.New("Server not found")
+	ErrSnapshotNotFound     = errors.New("Snapshot not found")
+	ErrTimeout              = errors.New("Timeout exceeded")
 	ErrUnknownInternal      = errors.New("Internal API error")
+	ErrVolumeFailed         = errors.New("Volume failed")
 	ErrVolumeNotFound       = errors.New("Volume not found")
 )
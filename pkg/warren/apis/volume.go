@@ -9,7 +9,23 @@ obtain one at http://mozilla.org/MPL/2.0/.
 // Package apis is the main package for Warren specific APIs
 package apis
 
-import "strings"
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gitlab.com/warrenio/library/go-client/warren"
+)
+
+const (
+	VolumeStatusActive = "active"
+	VolumeStatusError = "error"
+	VolumeStatusFailed = "failed"
+)
 
 func GetVolumeErrorFromHttpCallError(err error) error {
 	if nil == err {
@@ -22,6 +38,9 @@ func GetVolumeErrorFromHttpCallError(err error) error {
 		switch errString[1:4] {
 		case "404":
 			return ErrVolumeNotFound
+		case "409":
+			// Volumes are locked as long as the server they are attached to is
+			return ErrServerIsLocked
 		default:
 			return GetErrorFromHttpCallError(err)
 		}
@@ -29,3 +48,117 @@ func GetVolumeErrorFromHttpCallError(err error) error {
 
 	return err
 }
+
+// ResizeVolume grows the volume given to the new size. Volumes can not be
+// shrunk. Resizing is asynchronous, use WaitForVolumeResized to wait for the
+// new size.
+//
+// The vendored go-client does not support resizing. The request is sent to the
+// disk resource path the go-client uses for "GetDiskById" and
+// "DeleteDiskById" ("/storage/disk/{uuid}"). The endpoint is not covered by a
+// recorded cassette and has not been verified against the API reference yet.
+//
+// PARAMETERS
+// client   *warren.Client Warren client
+// uuid     string         Volume UUID
+// sizeInGB int            New volume size in GB
+func ResizeVolume(client *warren.Client, uuid string, sizeInGB int) (*warren.Disk, error) {
+	var disk warren.Disk
+
+	formParams := url.Values{}
+	formParams.Set("size_gb", strconv.Itoa(sizeInGB))
+
+	err := callAPI(client, "PATCH", "/storage/disk/" + uuid, formParams, &disk)
+	if nil != err {
+		return nil, GetVolumeErrorFromHttpCallError(err)
+	}
+
+	return &disk, nil
+}
+
+// WaitForVolumeResized waits until the volume given is active with the size
+// given.
+//
+// PARAMETERS
+// ctx      context.Context Execution context
+// client   *warren.Client  Warren client
+// uuid     string          Volume UUID
+// sizeInGB int             Volume size in GB expected
+// timeout  time.Duration   Timeout to wait
+func WaitForVolumeResized(ctx context.Context, client *warren.Client, uuid string, sizeInGB int, timeout time.Duration) (*warren.Disk, error) {
+	return waitForVolume(ctx, client, uuid, timeout, func(disk *warren.Disk) bool {
+		return sizeInGB == disk.SizeGb
+	})
+}
+
+// WaitForVolumeUsable waits until the volume given is active. Volumes ending
+// up in an error state are reported with their status comment.
+//
+// PARAMETERS
+// ctx     context.Context Execution context
+// client  *warren.Client  Warren client
+// uuid    string          Volume UUID
+// timeout time.Duration   Timeout to wait
+func WaitForVolumeUsable(ctx context.Context, client *warren.Client, uuid string, timeout time.Duration) (*warren.Disk, error) {
+	return waitForVolume(ctx, client, uuid, timeout, nil)
+}
+
+// waitForVolume waits until the volume given is active and the optional
+// condition given is met. Volumes ending up in an error state are reported
+// with their status comment.
+//
+// PARAMETERS
+// ctx         context.Context         Execution context
+// client      *warren.Client          Warren client
+// uuid        string                  Volume UUID
+// timeout     time.Duration           Timeout to wait
+// isCompleted func(*warren.Disk) bool Additional condition of active volumes
+func waitForVolume(ctx context.Context, client *warren.Client, uuid string, timeout time.Duration, isCompleted func(*warren.Disk) bool) (*warren.Disk, error) {
+	var disk *warren.Disk
+
+	lastStatus := ""
+	startedAt := time.Now()
+
+	err := Poll(ctx, timeout, func() (bool, error) {
+		var err error
+
+		disk, err = client.BlockStorage.GetDiskById(uuid)
+		if nil != err {
+			return false, GetVolumeErrorFromHttpCallError(err)
+		}
+
+		switch strings.ToLower(disk.Status) {
+		case VolumeStatusActive:
+			if nil == isCompleted || isCompleted(disk) {
+				return true, nil
+			}
+
+			tflog.Debug(ctx, fmt.Sprintf("Still waiting for volume %s with size %d GB after %s", uuid, disk.SizeGb, time.Since(startedAt).Round(time.Second)))
+
+			return false, nil
+		case VolumeStatusError, VolumeStatusFailed:
+			statusComment := disk.StatusComment
+
+			if "" == statusComment {
+				statusComment = "No status comment given"
+			}
+
+			return false, fmt.Errorf("%w with status %s: %s", ErrVolumeFailed, disk.Status, statusComment)
+		default:
+			if lastStatus != disk.Status {
+				tflog.Info(ctx, fmt.Sprintf("Waiting for volume %s with status %s after %s", uuid, disk.Status, time.Since(startedAt).Round(time.Second)))
+				lastStatus = disk.Status
+			} else {
+				tflog.Debug(ctx, fmt.Sprintf("Still waiting for volume %s with status %s after %s", uuid, disk.Status, time.Since(startedAt).Round(time.Second)))
+			}
+
+			return false, nil
+		}
+	})
+
+	if nil != err {
+		return nil, err
+	}
+
+	return disk, nil
+}
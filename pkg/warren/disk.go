@@ -0,0 +1,42 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package warren is the main provider code package for the Warren Platform
+package warren
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gitlab.com/warrenio/library/go-client/warren"
+)
+
+// DiskSnapshotListValue returns the Terraform list value for the given disk
+// snapshots.
+//
+// PARAMETERS
+// diskSnapshots []warren.Snapshot Disk snapshots
+func DiskSnapshotListValue(diskSnapshots []warren.Snapshot) types.List {
+	snapshots := []attr.Value{}
+
+	for _, diskSnapshot := range diskSnapshots {
+		snapshots = append(
+			snapshots,
+			types.ObjectValueMust(
+				DiskSnapshotType,
+				map[string]attr.Value{
+					"created_at": types.StringValue(diskSnapshot.CreatedAt),
+					"disk_uuid":  types.StringValue(diskSnapshot.DiskUuid),
+					"size_in_gb": types.Int64Value(int64(diskSnapshot.SizeGb)),
+					"uuid":       types.StringValue(diskSnapshot.Uuid),
+				},
+			),
+		)
+	}
+
+	return types.ListValueMust(types.ObjectType{ AttrTypes: DiskSnapshotType }, snapshots)
+}
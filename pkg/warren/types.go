@@ -9,7 +9,10 @@ obtain one at http://mozilla.org/MPL/2.0/.
 // Package warren is the main provider code package for the Warren Platform
 package warren
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // NetworkModel describes the data source and resource model for an network.
 type NetworkModel struct {
@@ -29,3 +32,28 @@ type NetworkModel struct {
 const PublishedName = "registry.terraform.io/warrenio/warren"
 
 var ProviderVersion = "v0.0.0"
+
+var (
+	DiskSnapshotType = map[string]attr.Type{
+		"created_at": types.StringType,
+		"disk_uuid":  types.StringType,
+		"size_in_gb": types.Int64Type,
+		"uuid":       types.StringType,
+	}
+	VirtualMachineStorageType = map[string]attr.Type{
+		"created_at": types.StringType,
+		"name":       types.StringType,
+		"primary":    types.BoolType,
+		"replica":    types.ListType{ ElemType: types.ObjectType{ AttrTypes: VirtualMachineStorageReplicaType } },
+		"size_in_gb": types.Int64Type,
+		"user_id":    types.Int64Type,
+		"uuid":       types.StringType,
+	}
+	VirtualMachineStorageReplicaType = map[string]attr.Type{
+		"created_at":  types.StringType,
+		"master_uuid": types.StringType,
+		"size_in_gb":  types.Int64Type,
+		"type":        types.StringType,
+		"uuid":        types.StringType,
+	}
+)
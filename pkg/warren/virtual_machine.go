@@ -0,0 +1,66 @@
+/*
+Copyright 2023 OYE Network OÜ. All rights reserved.
+
+This Source Code Form is subject to the terms of the Mozilla Public License,
+v. 2.0. If a copy of the MPL was not distributed with this file, You can
+obtain one at http://mozilla.org/MPL/2.0/.
+*/
+
+// Package warren is the main provider code package for the Warren Platform
+package warren
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gitlab.com/warrenio/library/go-client/warren"
+)
+
+// VirtualMachineStorageListValue returns the Terraform list value for the
+// given virtual machine storages.
+//
+// PARAMETERS
+// serverStorages []warren.VMStorage Virtual machine storages
+func VirtualMachineStorageListValue(serverStorages []warren.VMStorage) types.List {
+	storage := []attr.Value{}
+
+	for _, serverStorage := range serverStorages {
+		storageReplica := []attr.Value{}
+
+		for _, serverStorageReplica := range serverStorage.Replica {
+			storageReplica = append(
+				storageReplica,
+				types.ObjectValueMust(
+					VirtualMachineStorageReplicaType,
+					map[string]attr.Value{
+						"created_at":  types.StringValue(serverStorageReplica.CreatedAt),
+						"master_uuid": types.StringValue(serverStorageReplica.MasterUuid),
+						"size_in_gb":  types.Int64Value(int64(serverStorageReplica.Size)),
+						"type":        types.StringValue(serverStorageReplica.Type),
+						"uuid":        types.StringValue(serverStorageReplica.Uuid),
+					},
+				),
+			)
+		}
+
+		storage = append(
+			storage,
+			types.ObjectValueMust(
+				VirtualMachineStorageType,
+				map[string]attr.Value{
+					"created_at": types.StringValue(serverStorage.CreatedAt),
+					"name":       types.StringValue(serverStorage.Name),
+					"primary":    types.BoolValue(serverStorage.Primary),
+					"replica": types.ListValueMust(
+						types.ObjectType{AttrTypes: VirtualMachineStorageReplicaType},
+						storageReplica,
+					),
+					"size_in_gb": types.Int64Value(int64(serverStorage.Size)),
+					"user_id":    types.Int64Value(int64(serverStorage.UserId)),
+					"uuid":       types.StringValue(serverStorage.Uuid),
+				},
+			),
+		)
+	}
+
+	return types.ListValueMust(types.ObjectType{AttrTypes: VirtualMachineStorageType}, storage)
+}